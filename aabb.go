@@ -0,0 +1,297 @@
+package mathgl
+
+import "math"
+
+// 2 dimensional axis aligned bounding box. A box with Min greater than Max is
+// empty, which is what EmptyAABB2 returns.
+type AABB2 struct {
+	Min, Max Vec2
+}
+
+// 3 dimensional axis aligned bounding box. A box with Min greater than Max is
+// empty, which is what EmptyAABB3 returns.
+type AABB3 struct {
+	Min, Max Vec3
+}
+
+// Returns an empty box, which can be grown with Expand.
+func EmptyAABB2() AABB2 {
+	return AABB2{Vec2{math.MaxFloat32, math.MaxFloat32}, Vec2{-math.MaxFloat32, -math.MaxFloat32}}
+}
+
+// Returns the smallest box containing all the given points.
+func AABB2FromPoints(points []Vec2) AABB2 {
+	b := EmptyAABB2()
+	for i := range points {
+		b.ExpandPoint(&points[i])
+	}
+	return b
+}
+
+// Returns true if the box contains no points.
+func (b *AABB2) IsEmpty() bool {
+	return b.Min.X > b.Max.X || b.Min.Y > b.Max.Y
+}
+
+// Grows the box to contain the given point.
+func (b *AABB2) ExpandPoint(v *Vec2) {
+	b.Min.X = Fmin32(b.Min.X, v.X)
+	b.Min.Y = Fmin32(b.Min.Y, v.Y)
+	b.Max.X = Fmax32(b.Max.X, v.X)
+	b.Max.Y = Fmax32(b.Max.Y, v.Y)
+}
+
+// Grows the box by the given amount in every direction. A negative amount
+// shrinks the box.
+func (b *AABB2) Expand(amount float32) {
+	b.Min.X -= amount
+	b.Min.Y -= amount
+	b.Max.X += amount
+	b.Max.Y += amount
+}
+
+// Grows the box to contain the given box.
+func (b *AABB2) Union(x *AABB2) {
+	if x.IsEmpty() {
+		return
+	}
+	b.ExpandPoint(&x.Min)
+	b.ExpandPoint(&x.Max)
+}
+
+// Shrinks the box to the area shared with the given box. Returns false if the
+// boxes don't overlap, in which case the box is left empty.
+func (b *AABB2) Intersection(x *AABB2) bool {
+	b.Min.X = Fmax32(b.Min.X, x.Min.X)
+	b.Min.Y = Fmax32(b.Min.Y, x.Min.Y)
+	b.Max.X = Fmin32(b.Max.X, x.Max.X)
+	b.Max.Y = Fmin32(b.Max.Y, x.Max.Y)
+	return !b.IsEmpty()
+}
+
+// Returns true if the given point lies inside the box or on its border.
+func (b *AABB2) ContainsPoint(v *Vec2) bool {
+	return v.X >= b.Min.X && v.X <= b.Max.X &&
+		v.Y >= b.Min.Y && v.Y <= b.Max.Y
+}
+
+// Returns true if the given box lies completely inside the box.
+func (b *AABB2) Contains(x *AABB2) bool {
+	return b.ContainsPoint(&x.Min) && b.ContainsPoint(&x.Max)
+}
+
+// Returns true if the boxes overlap or touch.
+func (b *AABB2) Overlaps(x *AABB2) bool {
+	return b.Min.X <= x.Max.X && b.Max.X >= x.Min.X &&
+		b.Min.Y <= x.Max.Y && b.Max.Y >= x.Min.Y
+}
+
+// Returns the center of the box.
+func (b *AABB2) Center() Vec2 {
+	return Vec2{(b.Min.X + b.Max.X) * 0.5, (b.Min.Y + b.Max.Y) * 0.5}
+}
+
+// Returns the half size of the box along each axis.
+func (b *AABB2) Extents() Vec2 {
+	return Vec2{(b.Max.X - b.Min.X) * 0.5, (b.Max.Y - b.Min.Y) * 0.5}
+}
+
+// Returns the area of the box.
+func (b *AABB2) Area() float32 {
+	if b.IsEmpty() {
+		return 0.0
+	}
+	return (b.Max.X - b.Min.X) * (b.Max.Y - b.Min.Y)
+}
+
+// Returns the length of the border of the box.
+func (b *AABB2) Perimeter() float32 {
+	if b.IsEmpty() {
+		return 0.0
+	}
+	return 2.0 * ((b.Max.X - b.Min.X) + (b.Max.Y - b.Min.Y))
+}
+
+// Returns the smallest box containing the box transformed by the given Mat3.
+func (b *AABB2) Transform(m *Mat3) AABB2 {
+	if b.IsEmpty() {
+		return *b
+	}
+
+	// Arvo's method: for each axis pick the smaller and bigger product per
+	// matrix element instead of transforming all the corners.
+	result := AABB2{Vec2{m[6], m[7]}, Vec2{m[6], m[7]}}
+	min := [2]float32{b.Min.X, b.Min.Y}
+	max := [2]float32{b.Max.X, b.Max.Y}
+	rmin := [2]*float32{&result.Min.X, &result.Min.Y}
+	rmax := [2]*float32{&result.Max.X, &result.Max.Y}
+	for row := 0; row < 2; row++ {
+		for col := 0; col < 2; col++ {
+			e := m[row+3*col]
+			lo, hi := e*min[col], e*max[col]
+			if lo > hi {
+				lo, hi = hi, lo
+			}
+			*rmin[row] += lo
+			*rmax[row] += hi
+		}
+	}
+	return result
+}
+
+// Returns an empty box, which can be grown with Expand.
+func EmptyAABB3() AABB3 {
+	return AABB3{Vec3{math.MaxFloat32, math.MaxFloat32, math.MaxFloat32}, Vec3{-math.MaxFloat32, -math.MaxFloat32, -math.MaxFloat32}}
+}
+
+// Returns the smallest box containing all the given points.
+func AABB3FromPoints(points []Vec3) AABB3 {
+	b := EmptyAABB3()
+	for i := range points {
+		b.ExpandPoint(&points[i])
+	}
+	return b
+}
+
+// Returns true if the box contains no points.
+func (b *AABB3) IsEmpty() bool {
+	return b.Min.X > b.Max.X || b.Min.Y > b.Max.Y || b.Min.Z > b.Max.Z
+}
+
+// Grows the box to contain the given point.
+func (b *AABB3) ExpandPoint(v *Vec3) {
+	b.Min.X = Fmin32(b.Min.X, v.X)
+	b.Min.Y = Fmin32(b.Min.Y, v.Y)
+	b.Min.Z = Fmin32(b.Min.Z, v.Z)
+	b.Max.X = Fmax32(b.Max.X, v.X)
+	b.Max.Y = Fmax32(b.Max.Y, v.Y)
+	b.Max.Z = Fmax32(b.Max.Z, v.Z)
+}
+
+// Grows the box by the given amount in every direction. A negative amount
+// shrinks the box.
+func (b *AABB3) Expand(amount float32) {
+	b.Min.X -= amount
+	b.Min.Y -= amount
+	b.Min.Z -= amount
+	b.Max.X += amount
+	b.Max.Y += amount
+	b.Max.Z += amount
+}
+
+// Grows the box to contain the given box.
+func (b *AABB3) Union(x *AABB3) {
+	if x.IsEmpty() {
+		return
+	}
+	b.ExpandPoint(&x.Min)
+	b.ExpandPoint(&x.Max)
+}
+
+// Shrinks the box to the volume shared with the given box. Returns false if
+// the boxes don't overlap, in which case the box is left empty.
+func (b *AABB3) Intersection(x *AABB3) bool {
+	b.Min.X = Fmax32(b.Min.X, x.Min.X)
+	b.Min.Y = Fmax32(b.Min.Y, x.Min.Y)
+	b.Min.Z = Fmax32(b.Min.Z, x.Min.Z)
+	b.Max.X = Fmin32(b.Max.X, x.Max.X)
+	b.Max.Y = Fmin32(b.Max.Y, x.Max.Y)
+	b.Max.Z = Fmin32(b.Max.Z, x.Max.Z)
+	return !b.IsEmpty()
+}
+
+// Returns true if the given point lies inside the box or on its border.
+func (b *AABB3) ContainsPoint(v *Vec3) bool {
+	return v.X >= b.Min.X && v.X <= b.Max.X &&
+		v.Y >= b.Min.Y && v.Y <= b.Max.Y &&
+		v.Z >= b.Min.Z && v.Z <= b.Max.Z
+}
+
+// Returns true if the given box lies completely inside the box.
+func (b *AABB3) Contains(x *AABB3) bool {
+	return b.ContainsPoint(&x.Min) && b.ContainsPoint(&x.Max)
+}
+
+// Returns true if the boxes overlap or touch.
+func (b *AABB3) Overlaps(x *AABB3) bool {
+	return b.Min.X <= x.Max.X && b.Max.X >= x.Min.X &&
+		b.Min.Y <= x.Max.Y && b.Max.Y >= x.Min.Y &&
+		b.Min.Z <= x.Max.Z && b.Max.Z >= x.Min.Z
+}
+
+// Returns the center of the box.
+func (b *AABB3) Center() Vec3 {
+	return Vec3{(b.Min.X + b.Max.X) * 0.5, (b.Min.Y + b.Max.Y) * 0.5, (b.Min.Z + b.Max.Z) * 0.5}
+}
+
+// Returns the half size of the box along each axis.
+func (b *AABB3) Extents() Vec3 {
+	return Vec3{(b.Max.X - b.Min.X) * 0.5, (b.Max.Y - b.Min.Y) * 0.5, (b.Max.Z - b.Min.Z) * 0.5}
+}
+
+// Returns the surface area of the box.
+func (b *AABB3) SurfaceArea() float32 {
+	if b.IsEmpty() {
+		return 0.0
+	}
+	x, y, z := b.Max.X-b.Min.X, b.Max.Y-b.Min.Y, b.Max.Z-b.Min.Z
+	return 2.0 * (x*y + y*z + z*x)
+}
+
+// Returns the volume of the box.
+func (b *AABB3) Volume() float32 {
+	if b.IsEmpty() {
+		return 0.0
+	}
+	return (b.Max.X - b.Min.X) * (b.Max.Y - b.Min.Y) * (b.Max.Z - b.Min.Z)
+}
+
+// Returns the smallest box containing the box transformed by the given Mat4.
+// Projective matrices divide the corners by W, the box must then lie in front
+// of the eye where W is positive.
+func (b *AABB3) Transform(m *Mat4) AABB3 {
+	if b.IsEmpty() {
+		return *b
+	}
+
+	// The divide by W doesn't keep the order of the products, transform all
+	// the corners instead
+	if m[3] != 0 || m[7] != 0 || m[11] != 0 || m[15] != 1 {
+		result := EmptyAABB3()
+		for n := 0; n < 8; n++ {
+			corner := b.Min
+			if n&1 != 0 {
+				corner.X = b.Max.X
+			}
+			if n&2 != 0 {
+				corner.Y = b.Max.Y
+			}
+			if n&4 != 0 {
+				corner.Z = b.Max.Z
+			}
+			corner.TransformCoord(m)
+			result.ExpandPoint(&corner)
+		}
+		return result
+	}
+
+	// Arvo's method: for each axis pick the smaller and bigger product per
+	// matrix element instead of transforming all the corners.
+	result := AABB3{Vec3{m[12], m[13], m[14]}, Vec3{m[12], m[13], m[14]}}
+	min := [3]float32{b.Min.X, b.Min.Y, b.Min.Z}
+	max := [3]float32{b.Max.X, b.Max.Y, b.Max.Z}
+	rmin := [3]*float32{&result.Min.X, &result.Min.Y, &result.Min.Z}
+	rmax := [3]*float32{&result.Max.X, &result.Max.Y, &result.Max.Z}
+	for row := 0; row < 3; row++ {
+		for col := 0; col < 3; col++ {
+			e := m[row+4*col]
+			lo, hi := e*min[col], e*max[col]
+			if lo > hi {
+				lo, hi = hi, lo
+			}
+			*rmin[row] += lo
+			*rmax[row] += hi
+		}
+	}
+	return result
+}
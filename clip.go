@@ -0,0 +1,58 @@
+package mathgl
+
+// Reusable buffers for clipping polygons against convex polygons. Reusing a
+// PolyClipper avoids allocations once its buffers have grown large enough.
+type PolyClipper struct {
+	front, back Poly
+}
+
+// Clips the subject polygon against the convex, clockwise clip polygon using
+// the Sutherland–Hodgman algorithm and returns the part of subject inside
+// clip. The subject may be concave, but then the result can contain
+// degenerate edges along the border of clip. The returned polygon is owned by
+// the PolyClipper and only valid until its next use.
+func (c *PolyClipper) ClipConvex(subject, clip Poly) Poly {
+	c.front = append(c.front[:0], subject...)
+	for i := range clip {
+		if len(c.front) == 0 {
+			break
+		}
+		c.back = clipEdge(c.front, &clip[i], &clip[(i+1)%len(clip)], c.back[:0])
+		c.front, c.back = c.back, c.front
+	}
+	return c.front
+}
+
+// Returns the part of the polygon inside the convex, clockwise clip polygon.
+func (p Poly) ClipConvex(clip Poly) Poly {
+	var c PolyClipper
+	return c.ClipConvex(p, clip)
+}
+
+// Appends the part of p on the right of the line from a to b (including the
+// line itself) to out.
+func clipEdge(p Poly, a, b *Vec2, out Poly) Poly {
+	ex, ey := b.X-a.X, b.Y-a.Y
+	prev := &p[len(p)-1]
+	prevSide := ex*(prev.Y-a.Y) - ey*(prev.X-a.X)
+	for i := range p {
+		cur := &p[i]
+		side := ex*(cur.Y-a.Y) - ey*(cur.X-a.X)
+		// Points on the line are inside, they are never added twice as an
+		// intersection point.
+		if side <= 0 {
+			if prevSide > 0 && side != 0 {
+				out = append(out, lerpVec2(prev, cur, prevSide/(prevSide-side)))
+			}
+			out = append(out, *cur)
+		} else if prevSide < 0 {
+			out = append(out, lerpVec2(prev, cur, prevSide/(prevSide-side)))
+		}
+		prev, prevSide = cur, side
+	}
+	return out
+}
+
+func lerpVec2(a, b *Vec2, t float32) Vec2 {
+	return Vec2{a.X + (b.X-a.X)*t, a.Y + (b.Y-a.Y)*t}
+}
@@ -6,4 +6,5 @@ const (
 	PIover180  float32 = 0.017453292519943295
 	PIunder180 float32 = 57.29577951308232
 	epsilon    float32 = 1.0 / 64.0
+	epsilond   float64 = 1.0 / 64.0
 )
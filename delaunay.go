@@ -0,0 +1,264 @@
+package mathgl
+
+import "sort"
+
+// Triangle of a Delaunay triangulation. Vertices are indices into the
+// triangulated points in clockwise order. Neighbors[i] is the index of the
+// triangle sharing the edge from Vertices[i] to Vertices[(i+1)%3], or -1 if
+// that edge is on the convex hull.
+type DelaunayTriangle struct {
+	Vertices  [3]int
+	Neighbors [3]int
+}
+
+type delaunayTriangle struct {
+	a, b, c int
+	// Circumcircle
+	x, y, r2 float64
+}
+
+// Vertex at infinity. A triangle with it as c stands for the outside of the
+// hull edge from a to b.
+const delaunayGhost = -1
+
+// Returns the Delaunay triangulation of the points, using the Bowyer–Watson
+// algorithm. Duplicate points are only used once. Returns nil if the points
+// are collinear.
+func Delaunay(points []Vec2) []DelaunayTriangle {
+	n := len(points)
+	if n < 3 {
+		return nil
+	}
+
+	xs := make([]float64, n)
+	ys := make([]float64, n)
+	for i := range points {
+		xs[i], ys[i] = float64(points[i].X), float64(points[i].Y)
+	}
+	orient := func(a, b int, px, py float64) float64 {
+		return (xs[b]-xs[a])*(py-ys[a]) - (ys[b]-ys[a])*(px-xs[a])
+	}
+
+	// New triangles are counter-clockwise. Instead of a super triangle,
+	// which is never large enough to keep its vertices out of the
+	// circumcircles of all triangles on the hull, the outside of each hull
+	// edge is a triangle with a vertex at infinity.
+	newTriangle := func(a, b, c int) delaunayTriangle {
+		if a == delaunayGhost {
+			a, b, c = b, c, a
+		} else if b == delaunayGhost {
+			a, b, c = c, a, b
+		}
+		t := delaunayTriangle{a: a, b: b, c: c}
+		if c == delaunayGhost {
+			return t
+		}
+		bx, by := xs[b]-xs[a], ys[b]-ys[a]
+		ex, ey := xs[c]-xs[a], ys[c]-ys[a]
+		bl, el := bx*bx+by*by, ex*ex+ey*ey
+		d := 0.5 / (bx*ey - by*ex)
+		ox, oy := (ey*bl-by*el)*d, (bx*el-ex*bl)*d
+		t.x, t.y, t.r2 = xs[a]+ox, ys[a]+oy, ox*ox+oy*oy
+		return t
+	}
+
+	// Inserting the points from left to right allows closing every triangle
+	// whose circumcircle lies left of the current point.
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.Sort(pointsByXY{order, points})
+	unique := order[:1]
+	for _, i := range order[1:] {
+		if points[i] != points[unique[len(unique)-1]] {
+			unique = append(unique, i)
+		}
+	}
+	order = unique
+
+	// The points up to the first one off their line can only be connected
+	// to it
+	first := 2
+	for first < len(order) && orient(order[0], order[1], xs[order[first]], ys[order[first]]) == 0 {
+		first++
+	}
+	if first == len(order) {
+		return nil
+	}
+	var open, closed []delaunayTriangle
+	apex := order[first]
+	for k := 0; k+1 < first; k++ {
+		a, b := order[k], order[k+1]
+		if orient(a, b, xs[apex], ys[apex]) < 0 {
+			a, b = b, a
+		}
+		open = append(open, newTriangle(a, b, apex), newTriangle(b, a, delaunayGhost))
+	}
+	if orient(order[0], order[first-1], xs[apex], ys[apex]) > 0 {
+		open = append(open, newTriangle(apex, order[first-1], delaunayGhost), newTriangle(order[0], apex, delaunayGhost))
+	} else {
+		open = append(open, newTriangle(order[first-1], apex, delaunayGhost), newTriangle(apex, order[0], delaunayGhost))
+	}
+
+	var edges [][2]int
+	for _, i := range order[first+1:] {
+		px, py := xs[i], ys[i]
+
+		// Remove the triangles whose circumcircle contains the point, or
+		// whose hull edge it lies beyond, and collect the border of the
+		// resulting cavity.
+		edges = edges[:0]
+		kept := open[:0]
+		for _, t := range open {
+			if t.c == delaunayGhost {
+				if orient(t.a, t.b, px, py) <= 0 {
+					kept = append(kept, t)
+					continue
+				}
+			} else {
+				dx := px - t.x
+				if dx > 0 && dx*dx > t.r2 {
+					closed = append(closed, t)
+					continue
+				}
+				if inCircle(xs, ys, t.a, t.b, t.c, px, py) <= 0 {
+					kept = append(kept, t)
+					continue
+				}
+			}
+			edges = addCavityEdge(edges, t.a, t.b)
+			edges = addCavityEdge(edges, t.b, t.c)
+			edges = addCavityEdge(edges, t.c, t.a)
+		}
+		open = kept
+		for _, e := range edges {
+			open = append(open, newTriangle(e[0], e[1], i))
+		}
+	}
+	closed = append(closed, open...)
+
+	// Drop the triangles outside of the hull, and flip the remaining ones to
+	// clockwise.
+	var result []DelaunayTriangle
+	for _, t := range closed {
+		if t.c != delaunayGhost {
+			result = append(result, DelaunayTriangle{Vertices: [3]int{t.a, t.c, t.b}})
+		}
+	}
+
+	neighbors := make(map[[2]int]int, 3*len(result))
+	for i := range result {
+		v := &result[i].Vertices
+		for j := 0; j < 3; j++ {
+			neighbors[[2]int{v[j], v[(j+1)%3]}] = i
+		}
+	}
+	for i := range result {
+		v := &result[i].Vertices
+		for j := 0; j < 3; j++ {
+			if t, ok := neighbors[[2]int{v[(j+1)%3], v[j]}]; ok {
+				result[i].Neighbors[j] = t
+			} else {
+				result[i].Neighbors[j] = -1
+			}
+		}
+	}
+	return result
+}
+
+// Adds the edge from a to b to the border of a cavity, unless it's shared
+// with an already removed triangle.
+func addCavityEdge(edges [][2]int, a, b int) [][2]int {
+	for i := range edges {
+		if edges[i][0] == b && edges[i][1] == a {
+			edges[i] = edges[len(edges)-1]
+			return edges[:len(edges)-1]
+		}
+	}
+	return append(edges, [2]int{a, b})
+}
+
+// Returns a positive value if the point is inside the circumcircle of the
+// counter-clockwise triangle a, b, c, a negative one if it's outside and 0 if
+// it's on the circle.
+func inCircle(xs, ys []float64, a, b, c int, px, py float64) float64 {
+	ax, ay := xs[a]-px, ys[a]-py
+	bx, by := xs[b]-px, ys[b]-py
+	cx, cy := xs[c]-px, ys[c]-py
+	al, bl, cl := ax*ax+ay*ay, bx*bx+by*by, cx*cx+cy*cy
+	return ax*(by*cl-bl*cy) - ay*(bx*cl-bl*cx) + al*(bx*cy-by*cx)
+}
+
+type pointsByXY struct {
+	order  []int
+	points []Vec2
+}
+
+func (p pointsByXY) Len() int      { return len(p.order) }
+func (p pointsByXY) Swap(i, j int) { p.order[i], p.order[j] = p.order[j], p.order[i] }
+func (p pointsByXY) Less(i, j int) bool {
+	a, b := &p.points[p.order[i]], &p.points[p.order[j]]
+	if a.X != b.X {
+		return a.X < b.X
+	}
+	if a.Y != b.Y {
+		return a.Y < b.Y
+	}
+	return p.order[i] < p.order[j]
+}
+
+// Returns the Voronoi diagram of the points. The cell of points[i] is
+// returned at index i as a clockwise, convex Poly clipped to the bounds.
+// Cells of duplicate points after their first occurrence are nil.
+func Voronoi(points []Vec2, bounds AABB2) []Poly {
+	cells := make([]Poly, len(points))
+
+	// The neighbors of each point in the Delaunay triangulation define its
+	// cell. If there is no triangulation all points are neighbors.
+	neighbors := make([][]int, len(points))
+	triangles := Delaunay(points)
+	for _, t := range triangles {
+		for j := 0; j < 3; j++ {
+			a, b := t.Vertices[j], t.Vertices[(j+1)%3]
+			if t.Neighbors[j] == -1 || a < b {
+				neighbors[a] = append(neighbors[a], b)
+				neighbors[b] = append(neighbors[b], a)
+			}
+		}
+	}
+
+	first := make(map[Vec2]int, len(points))
+	var c PolyClipper
+	for i := range points {
+		p := &points[i]
+		if _, ok := first[*p]; ok {
+			continue
+		}
+		first[*p] = i
+
+		cell := Poly{bounds.Min, {bounds.Min.X, bounds.Max.Y}, bounds.Max, {bounds.Max.X, bounds.Min.Y}}
+		clip := func(q *Vec2) {
+			if *q == *p || len(cell) == 0 {
+				return
+			}
+			// Keep the side of the bisector containing p
+			nx, ny := q.X-p.X, q.Y-p.Y
+			a := Vec2{(p.X + q.X) / 2, (p.Y + q.Y) / 2}
+			b := Vec2{a.X + ny, a.Y - nx}
+			c.back = clipEdge(cell, &a, &b, c.back[:0])
+			cell, c.back = c.back, cell
+		}
+		if triangles == nil {
+			for j := range points {
+				clip(&points[j])
+			}
+		} else {
+			for _, j := range neighbors[i] {
+				clip(&points[j])
+			}
+		}
+		cells[i] = append(Poly(nil), cell...)
+	}
+	return cells
+}
@@ -0,0 +1,122 @@
+package mathgl
+
+type FrustumClassificationEnum int
+
+const (
+	OUTSIDE_FRUSTUM FrustumClassificationEnum = iota
+	INSIDE_FRUSTUM
+	INTERSECTS_FRUSTUM
+)
+
+// View frustum given by six normalized planes whose normals point inwards.
+// The planes are indexed by PlaneEnum.
+type Frustum struct {
+	Planes [6]Plane
+}
+
+// Extracts the frustum planes from the given view-projection matrix. Pass a
+// projection matrix only to get the frustum in camera coordinates.
+func (f *Frustum) ExtractPlanes(viewProjection *Mat4) {
+	for i := range f.Planes {
+		f.Planes[i] = *viewProjection.ExtractPlane(PlaneEnum(i))
+	}
+}
+
+// Returns whether the given point lies inside or outside the frustum.
+func (f *Frustum) ClassifyPoint(v *Vec3) FrustumClassificationEnum {
+	for i := range f.Planes {
+		if f.Planes[i].DotCoord(v) < 0.0 {
+			return OUTSIDE_FRUSTUM
+		}
+	}
+	return INSIDE_FRUSTUM
+}
+
+// Returns whether the given sphere lies inside, outside or on the border of
+// the frustum.
+func (f *Frustum) ClassifySphere(s *Sphere) FrustumClassificationEnum {
+	result := INSIDE_FRUSTUM
+	for i := range f.Planes {
+		d := f.Planes[i].DotCoord(&s.Center)
+		if d < -s.Radius {
+			return OUTSIDE_FRUSTUM
+		}
+		if d < s.Radius {
+			result = INTERSECTS_FRUSTUM
+		}
+	}
+	return result
+}
+
+// Returns whether the axis aligned box given by its min and max corners lies
+// inside, outside or on the border of the frustum. Boxes near the corners of
+// the frustum may be reported as intersecting although they are outside.
+func (f *Frustum) ClassifyBox(min, max *Vec3) FrustumClassificationEnum {
+	result := INSIDE_FRUSTUM
+	for i := range f.Planes {
+		p := &f.Planes[i]
+
+		// The corners which are the farthest along and against the plane normal
+		var positive, negative Vec3
+		positive.Assign(min)
+		negative.Assign(max)
+		if p.A >= 0.0 {
+			positive.X, negative.X = max.X, min.X
+		}
+		if p.B >= 0.0 {
+			positive.Y, negative.Y = max.Y, min.Y
+		}
+		if p.C >= 0.0 {
+			positive.Z, negative.Z = max.Z, min.Z
+		}
+
+		if p.DotCoord(&positive) < 0.0 {
+			return OUTSIDE_FRUSTUM
+		}
+		if p.DotCoord(&negative) < 0.0 {
+			result = INTERSECTS_FRUSTUM
+		}
+	}
+	return result
+}
+
+// Classifies all the given spheres against the frustum. The results are
+// appended to result[:0], so the slice of a previous call can be reused.
+func (f *Frustum) ClassifySpheres(spheres []Sphere, result []FrustumClassificationEnum) []FrustumClassificationEnum {
+	result = result[:0]
+	for i := range spheres {
+		result = append(result, f.ClassifySphere(&spheres[i]))
+	}
+	return result
+}
+
+// Returns the indices of the spheres which are at least partly inside the
+// frustum. The indices are appended to visible[:0], so the slice of a previous
+// call can be reused.
+func (f *Frustum) CullSpheres(spheres []Sphere, visible []int) []int {
+	visible = visible[:0]
+	for i := range spheres {
+		if f.ClassifySphere(&spheres[i]) != OUTSIDE_FRUSTUM {
+			visible = append(visible, i)
+		}
+	}
+	return visible
+}
+
+// Returns whether the box lies inside, outside or on the border of the frustum.
+func (f *Frustum) ClassifyAABB(b *AABB3) FrustumClassificationEnum {
+	return f.ClassifyBox(&b.Min, &b.Max)
+}
+
+// Returns the indices of the boxes which are at least partly inside the
+// frustum. The indices are appended to visible[:0], so the slice of a previous
+// call can be reused.
+func (f *Frustum) CullAABBs(boxes []AABB3, visible []int) []int {
+	visible = visible[:0]
+	for i := range boxes {
+		if f.ClassifyBox(&boxes[i].Min, &boxes[i].Max) != OUTSIDE_FRUSTUM {
+			visible = append(visible, i)
+		}
+	}
+	return visible
+}
@@ -0,0 +1,61 @@
+package mathgl
+
+import "math"
+
+// Double precision counterparts of the float32 functions.
+
+// The Square of a given float64.
+func Fsqr64(s float64) float64 {
+	return s * s
+}
+
+// Returns the square root of a given float64.
+func Fsqrt64(x float64) float64 {
+	return math.Sqrt(x)
+}
+
+// Returns the radius value from a given degree value given in float64.
+func Fdeg2rad64(degrees float64) float64 {
+	return degrees * (math.Pi / 180)
+}
+
+// Returns the degrees value from a given radius value given in float64.
+func Frad2deg64(radians float64) float64 {
+	return radians * (180 / math.Pi)
+}
+
+// Returns the absolute value from a float64
+func Fabs64(f float64) float64 {
+	return math.Abs(f)
+}
+
+// Returns the smallest value of two given float64 values.
+func Fmin64(lhs float64, rhs float64) float64 {
+	if lhs < rhs {
+		return lhs
+	}
+	return rhs
+}
+
+// Returns biggest value of two given float64 values.
+func Fmax64(lhs float64, rhs float64) float64 {
+	if lhs > rhs {
+		return lhs
+	}
+	return rhs
+}
+
+// Returns true if two float64 are almost the same (the threshold is epsilon = 1/64).
+func FalmostEqual64(lhs float64, rhs float64) bool {
+	return (lhs+epsilond > rhs && lhs-epsilond < rhs)
+}
+
+// Returns the sin of a given float64 radiant. Unlike Fsin32 this is exact.
+func Fsin64(x float64) float64 {
+	return math.Sin(x)
+}
+
+// Returns the cos of a given float64 radiant. Unlike Fcos32 this is exact.
+func Fcos64(x float64) float64 {
+	return math.Cos(x)
+}
@@ -0,0 +1,61 @@
+//go:build ignore
+
+// Generates swizzle.go, run it with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+)
+
+var types = []struct {
+	name       string
+	components string
+}{
+	{"Vec2Of", "XY"},
+	{"Vec3Of", "XYZ"},
+	{"Vec4Of", "XYZW"},
+}
+
+func main() {
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "// Code generated by gen_swizzle.go; DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package mathgl")
+
+	for _, t := range types {
+		for n := 2; n <= 4; n++ {
+			swizzles(&b, t.name, t.components, "", n)
+		}
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("swizzle.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Writes all swizzles of the given length starting with prefix, components
+// may be repeated like in GLSL.
+func swizzles(b *bytes.Buffer, name, components, prefix string, n int) {
+	if len(prefix) == n {
+		fields := make([]string, n)
+		for i, c := range prefix {
+			fields[i] = "v." + string(c)
+		}
+		result := fmt.Sprintf("{%s}", strings.Join(fields, ", "))
+		fmt.Fprintf(b, "\n// Returns Vec%d%s\n", n, result)
+		fmt.Fprintf(b, "func (v %s[T]) %s() Vec%dOf[T] {\n\treturn Vec%dOf[T]%s\n}\n", name, prefix, n, n, result)
+		return
+	}
+	for _, c := range components {
+		swizzles(b, name, components, prefix+string(c), n)
+	}
+}
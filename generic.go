@@ -0,0 +1,122 @@
+package mathgl
+
+import (
+	"fmt"
+	"math"
+)
+
+// Element types of the vectors, matrices, quaternions and planes.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// Floating point element types.
+type Float interface {
+	~float32 | ~float64
+}
+
+// All methods are written once for the generic types like Vec3Of, the
+// concrete types are aliases of their instances: Vec3 is Vec3Of[float32],
+// Vec3d is Vec3Of[float64] and Vec3i is Vec3Of[int32]. Methods which need
+// fractions say in their documentation how they behave for integer element
+// types.
+
+func minOf[T Number](a, b T) T {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxOf[T Number](a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func absOf[T Number](a T) T {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// Returns true if T is a floating point type, including types defined on
+// float32 or float64. Tested with conversions, as a type switch only matches
+// float32 and float64 themselves.
+func isFloat[T Number]() bool {
+	half := 0.5
+	return T(half) != 0
+}
+
+// Returns true if T is float32 or a type defined on it, which are too coarse
+// to tell 1 and 1+1e-10 apart.
+func isFloat32[T Number]() bool {
+	tiny := 1e-10
+	return isFloat[T]() && T(1+tiny) == 1
+}
+
+func sqrtOf[T Number](a T) T {
+	if isFloat32[T]() {
+		return T(Fsqrt32(float32(a)))
+	}
+	return T(math.Sqrt(float64(a)))
+}
+
+// Fsin32 and Fcos32 stay the float32 approximations, other element types use
+// the math package.
+func sinOf[T Number](a T) T {
+	if isFloat32[T]() {
+		return T(Fsin32(float32(a)))
+	}
+	return T(math.Sin(float64(a)))
+}
+
+func cosOf[T Number](a T) T {
+	if isFloat32[T]() {
+		return T(Fcos32(float32(a)))
+	}
+	return T(math.Cos(float64(a)))
+}
+
+func deg2radOf[T Number](a T) T {
+	return T(float64(a) * math.Pi / 180)
+}
+
+// Returns true if a and b differ by less than epsilon, integers must be equal.
+func almostEqual[T Number](a, b T) bool {
+	e := epsilond
+	if t := T(e); t != 0 {
+		return a < b+t && a > b-t
+	}
+	return a == b
+}
+
+// Formats floats like %f and integers like %d.
+func formatOf[T Number](a T) string {
+	if isFloat[T]() {
+		return fmt.Sprintf("%f", float64(a))
+	}
+	return fmt.Sprint(a)
+}
+
+// Converts the elements of the vector to another type, like the conversion
+// T(x) does for each of them
+func ConvertVec2[T, U Number](v Vec2Of[U]) Vec2Of[T] {
+	return Vec2Of[T]{T(v.X), T(v.Y)}
+}
+
+// Converts the elements of the vector to another type, like the conversion
+// T(x) does for each of them
+func ConvertVec3[T, U Number](v Vec3Of[U]) Vec3Of[T] {
+	return Vec3Of[T]{T(v.X), T(v.Y), T(v.Z)}
+}
+
+// Converts the elements of the vector to another type, like the conversion
+// T(x) does for each of them
+func ConvertVec4[T, U Number](v Vec4Of[U]) Vec4Of[T] {
+	return Vec4Of[T]{T(v.X), T(v.Y), T(v.Z), T(v.W)}
+}
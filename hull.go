@@ -0,0 +1,301 @@
+package mathgl
+
+import "sort"
+
+// Returns the convex hull of the points as a clockwise Poly, using Andrew's
+// monotone chain algorithm. Collinear points on the hull are left out. Fewer
+// than 3 distinct points give a degenerate hull with 0 to 2 vertices.
+func ConvexHull2(points []Vec2) Poly {
+	sorted := make([]Vec2, len(points))
+	copy(sorted, points)
+	sort.Sort(vec2ByXY(sorted))
+
+	// Remove duplicates
+	unique := sorted[:0]
+	for i := range sorted {
+		if i == 0 || sorted[i] != sorted[i-1] {
+			unique = append(unique, sorted[i])
+		}
+	}
+	if len(unique) < 3 {
+		return Poly(unique)
+	}
+
+	turn := func(o, a, b *Vec2) float32 {
+		return (a.X-o.X)*(b.Y-o.Y) - (a.Y-o.Y)*(b.X-o.X)
+	}
+
+	// Builds the counter-clockwise hull: the lower chain from left to right,
+	// then the upper chain back.
+	hull := make(Poly, 0, 2*len(unique))
+	for i := range unique {
+		for len(hull) >= 2 && turn(&hull[len(hull)-2], &hull[len(hull)-1], &unique[i]) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, unique[i])
+	}
+	lower := len(hull) + 1
+	for i := len(unique) - 2; i >= 0; i-- {
+		for len(hull) >= lower && turn(&hull[len(hull)-2], &hull[len(hull)-1], &unique[i]) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, unique[i])
+	}
+	hull = hull[:len(hull)-1]
+
+	hull.Reverse()
+	return hull
+}
+
+type vec2ByXY []Vec2
+
+func (v vec2ByXY) Len() int      { return len(v) }
+func (v vec2ByXY) Swap(i, j int) { v[i], v[j] = v[j], v[i] }
+func (v vec2ByXY) Less(i, j int) bool {
+	return v[i].X < v[j].X || (v[i].X == v[j].X && v[i].Y < v[j].Y)
+}
+
+// Triangle of a 3D convex hull. Vertices are indices into the points the
+// hull was built from, in counter-clockwise order seen from outside. The
+// normal of Plane points out of the hull.
+type HullFace struct {
+	Vertices [3]int
+	Plane    Plane
+}
+
+type hullFace struct {
+	HullFace
+	// Neighbors[k] shares the edge from Vertices[k] to Vertices[k+1]
+	neighbors [3]*hullFace
+	outside   []int
+	alive     bool
+	visible   bool
+}
+
+// Edge of the region seen from the eye point, with the face beyond it
+type hullEdge struct {
+	a, b     int
+	neighbor *hullFace
+}
+
+// Returns the faces of the convex hull of the points, using the quickhull
+// algorithm. Returns nil if the points don't span a volume.
+func ConvexHull3(points []Vec3) []HullFace {
+	if len(points) < 4 {
+		return nil
+	}
+
+	var scale float32
+	for i := range points {
+		scale = Fmax32(scale, Fabs32(points[i].X)+Fabs32(points[i].Y)+Fabs32(points[i].Z))
+	}
+	tolerance := 1e-5 * Fmax32(scale, 1)
+
+	initial := hullSimplex(points, tolerance)
+	if initial == nil {
+		return nil
+	}
+
+	var faces []*hullFace
+	newFace := func(a, b, c int) *hullFace {
+		f := &hullFace{alive: true}
+		f.Vertices = [3]int{a, b, c}
+		f.Plane.FromPoints(&points[a], &points[b], &points[c])
+		faces = append(faces, f)
+		return f
+	}
+
+	// Orient the tetrahedron so every face points away from the fourth vertex
+	a, b, c, d := initial[0], initial[1], initial[2], initial[3]
+	var base Plane
+	base.FromPoints(&points[a], &points[b], &points[c])
+	if base.DotCoord(&points[d]) > 0 {
+		b, c = c, b
+	}
+	created := []*hullFace{newFace(a, b, c), newFace(a, d, b), newFace(b, d, c), newFace(c, d, a)}
+	for _, f := range created {
+		for k := 0; k < 3; k++ {
+			for _, g := range created {
+				if g.edge(f.Vertices[(k+1)%3], f.Vertices[k]) != -1 {
+					f.neighbors[k] = g
+				}
+			}
+		}
+	}
+
+	var pending []int
+	for i := range points {
+		if i != a && i != b && i != c && i != d {
+			pending = append(pending, i)
+		}
+	}
+	assignOutside(points, pending, created, tolerance)
+
+	var visible []*hullFace
+	var horizon []hullEdge
+	// Marks the faces seeing the eye point which are reachable from f, and
+	// collects the edges to the other faces in counter-clockwise order.
+	// from is the edge f was entered through.
+	var findHorizon func(f *hullFace, from int, eye int)
+	findHorizon = func(f *hullFace, from int, eye int) {
+		f.visible = true
+		visible = append(visible, f)
+		for i := 1; i <= 3; i++ {
+			k := (from + i) % 3
+			a, b := f.Vertices[k], f.Vertices[(k+1)%3]
+			n := f.neighbors[k]
+			if n.visible {
+				continue
+			}
+			j := n.edge(b, a)
+			if h := n.Plane.DotCoord(&points[eye]); h > tolerance ||
+				(h > 0 && hullConcave(points, a, b, eye, n.Vertices[(j+2)%3], tolerance)) {
+				findHorizon(n, j, eye)
+			} else {
+				horizon = append(horizon, hullEdge{a, b, n})
+			}
+		}
+	}
+
+	// Faces only get outside points when they are created, so the faces
+	// before next never need to be looked at again
+	for next := 0; next < len(faces); next++ {
+		face := faces[next]
+		if !face.alive || len(face.outside) == 0 {
+			continue
+		}
+
+		// The farthest point outside of it is on the hull
+		eye := face.outside[0]
+		for _, i := range face.outside {
+			if face.Plane.DotCoord(&points[i]) > face.Plane.DotCoord(&points[eye]) {
+				eye = i
+			}
+		}
+
+		// Remove the faces seeing the eye point, starting from this one so
+		// they form one connected region bounded by the horizon
+		visible, horizon = visible[:0], horizon[:0]
+		findHorizon(face, 2, eye)
+		pending = pending[:0]
+		for _, f := range visible {
+			f.alive = false
+			for _, i := range f.outside {
+				if i != eye {
+					pending = append(pending, i)
+				}
+			}
+		}
+
+		// Connect the horizon to the eye point, each new face shares its
+		// second edge with the next one
+		created = created[:0]
+		for _, e := range horizon {
+			f := newFace(e.a, e.b, eye)
+			f.neighbors[0] = e.neighbor
+			e.neighbor.neighbors[e.neighbor.edge(e.b, e.a)] = f
+			created = append(created, f)
+		}
+		for i, f := range created {
+			g := created[(i+1)%len(created)]
+			f.neighbors[1] = g
+			g.neighbors[2] = f
+		}
+		assignOutside(points, pending, created, tolerance)
+	}
+
+	var result []HullFace
+	for _, f := range faces {
+		if f.alive {
+			result = append(result, f.HullFace)
+		}
+	}
+	return result
+}
+
+// Returns true if the face from a to b to eye would be bent inwards at its
+// edge from a to b by more than the tolerance, seen from the vertex opposite
+// of that edge in the face beyond it. This happens for slivers even when the
+// eye is only within the tolerance above the face beyond.
+func hullConcave(points []Vec3, a, b, eye, opposite int, tolerance float32) bool {
+	var plane Plane
+	plane.FromPoints(&points[a], &points[b], &points[eye])
+	return plane.DotCoord(&points[opposite]) > tolerance
+}
+
+// Returns k if the face has the edge from Vertices[k] to a to b, or -1.
+func (f *hullFace) edge(a, b int) int {
+	for k := 0; k < 3; k++ {
+		if f.Vertices[k] == a && f.Vertices[(k+1)%3] == b {
+			return k
+		}
+	}
+	return -1
+}
+
+// Assigns each point to the first face it lies outside of. Points inside all
+// faces are dropped.
+func assignOutside(points []Vec3, indices []int, faces []*hullFace, tolerance float32) {
+	for _, i := range indices {
+		for _, f := range faces {
+			if f.Plane.DotCoord(&points[i]) > tolerance {
+				f.outside = append(f.outside, i)
+				break
+			}
+		}
+	}
+}
+
+// Returns the indices of four points spanning a tetrahedron, or nil if the
+// points are coplanar.
+func hullSimplex(points []Vec3, tolerance float32) []int {
+	// Extreme points along the X axis
+	a, b := 0, 0
+	for i := range points {
+		if points[i].X < points[a].X {
+			a = i
+		}
+		if points[i].X > points[b].X {
+			b = i
+		}
+	}
+	if a == b {
+		return nil
+	}
+
+	// Farthest point from the line through a and b
+	var ab Vec3
+	ab.Assign(&points[b])
+	ab.Subtract(&points[a])
+	c := -1
+	var best float32
+	for i := range points {
+		var ai Vec3
+		ai.Assign(&points[i])
+		ai.Subtract(&points[a])
+		ai.Cross(&ab)
+		if d := ai.Length(); d > best {
+			best = d
+			c = i
+		}
+	}
+	if c == -1 || best <= tolerance*ab.Length() {
+		return nil
+	}
+
+	// Farthest point from the plane through a, b and c
+	var plane Plane
+	plane.FromPoints(&points[a], &points[b], &points[c])
+	d := -1
+	best = 0
+	for i := range points {
+		if dist := Fabs32(plane.DotCoord(&points[i])); dist > best {
+			best = dist
+			d = i
+		}
+	}
+	if d == -1 || best <= tolerance {
+		return nil
+	}
+	return []int{a, b, c, d}
+}
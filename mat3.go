@@ -2,10 +2,16 @@
 package mathgl
 
 // 3x3 Matrix type. Column major.
-type Mat3 [9]float32
+type Mat3Of[T Number] [9]T
+
+// Matrices with float32 and float64 elements.
+type (
+	Mat3  = Mat3Of[float32]
+	Mat3d = Mat3Of[float64]
+)
 
 // Sets the matrix to a 3x3 identity matrix.
-func (m *Mat3) Identity() {
+func (m *Mat3Of[T]) Identity() {
 	m[0] = 1
 	m[1] = 0
 	m[2] = 0
@@ -19,16 +25,16 @@ func (m *Mat3) Identity() {
 	m[8] = 1
 }
 
-// Fills the matrix with the given float32.
-func (m *Mat3) Fill(content float32) {
+// Fills the matrix with the given value.
+func (m *Mat3Of[T]) Fill(content T) {
 	for i := range m {
 		m[i] = content
 	}
 }
 
-// Returns the calculated determinant from the matrix as float32.
-func (m *Mat3) Determinant() float32 {
-	var determinant float32
+// Returns the calculated determinant from the matrix.
+func (m *Mat3Of[T]) Determinant() T {
+	var determinant T
 
 	// We use the rule of sarrus to get the determinant
 	determinant = m[0]*m[4]*m[8] + m[1]*m[5]*m[6] + m[2]*m[3]*m[7]
@@ -38,8 +44,8 @@ func (m *Mat3) Determinant() float32 {
 }
 
 // Adjugates the matrix.
-func (m *Mat3) Adjugate() {
-	var adjugate Mat3
+func (m *Mat3Of[T]) Adjugate() {
+	var adjugate Mat3Of[T]
 
 	//  the transpose of its cofactor matrix
 	adjugate[0] = m[4]*m[8] - m[5]*m[7]
@@ -56,7 +62,9 @@ func (m *Mat3) Adjugate() {
 }
 
 // Inverse the matrix. Returns true if the inverse could be build.
-func (m *Mat3) Inverse() bool {
+// Integer matrices truncate the reciprocal of the determinant, so only a
+// determinant of 1 or -1 gives the inverse.
+func (m *Mat3Of[T]) Inverse() bool {
 	determinate := m.Determinant()
 
 	if determinate == 0.0 {
@@ -72,8 +80,8 @@ func (m *Mat3) Inverse() bool {
 
 
 // Returns true if the matrix is a identity matrix.
-func (m *Mat3) IsIdentity() bool {
-	var identity Mat3
+func (m *Mat3Of[T]) IsIdentity() bool {
+	var identity Mat3Of[T]
 	identity.Identity()
 	if m.AreEqual(&identity) {
 		return true
@@ -82,8 +90,8 @@ func (m *Mat3) IsIdentity() bool {
 }
 
 // Transpose the matrix
-func (m *Mat3) Transpose() {
-	var tmp Mat3
+func (m *Mat3Of[T]) Transpose() {
+	var tmp Mat3Of[T]
 	for z := 0; z < 3; z++ {
 		for x := 0; x < 3; x++ {
 			tmp[(z*3)+x] = m[(x*3)+z]
@@ -93,8 +101,8 @@ func (m *Mat3) Transpose() {
 }
 
 // Multiplies the matrix with a given Mat3 matrix
-func (m *Mat3) Multiply(in *Mat3) {
-	var out Mat3
+func (m *Mat3Of[T]) Multiply(in *Mat3Of[T]) {
+	var out Mat3Of[T]
 
 	out[0] = m[0]*in[0] + m[3]*in[1] + m[6]*in[2]
 	out[1] = m[1]*in[0] + m[4]*in[1] + m[7]*in[2]
@@ -111,84 +119,86 @@ func (m *Mat3) Multiply(in *Mat3) {
 	*m = out
 }
 
-// Multiplies the matrix with a given scalar in float32.
-func (m *Mat3) ScalarMultiply(factor float32) {
+// Multiplies the matrix with a given scalar.
+func (m *Mat3Of[T]) ScalarMultiply(factor T) {
 	for i := range m {
 		m[i] *= factor
 	}
 }
 
 // Assigns the values of the input matrix
-func (m *Mat3) Assign(input *Mat3) {
+func (m *Mat3Of[T]) Assign(input *Mat3Of[T]) {
 	for i, x := range input {
 		m[i] = x
 	}
 }
 
 // Returns true if the 2 matrices are equal (approximately)
-func (m *Mat3) AreEqual(candidate *Mat3) bool {
+func (m *Mat3Of[T]) AreEqual(candidate *Mat3Of[T]) bool {
 	for i, x := range candidate {
-		if !(m[i]+epsilon > x &&
-			m[i]-epsilon < x) {
+		if !almostEqual(m[i], x) {
 			return false
 		}
 	}
 	return true
 }
 
-// Set the matrix to a scaling matrix, which scale with given x,y floats32
-func (m *Mat3) Scaling(x, y float32) {
+// Set the matrix to a scaling matrix, which scale with given x,y
+func (m *Mat3Of[T]) Scaling(x, y T) {
 	m.Identity()
 	m[0] = x
 	m[4] = y
 }
 
 
-// Set the matrix to a translation matrix, which translates with given x,y floats32
-func (m *Mat3) Translation(x, y float32) {
+// Set the matrix to a translation matrix, which translates with given x,y
+func (m *Mat3Of[T]) Translation(x, y T) {
 	m.Identity()
 	m[6] = x
 	m[7] = y
 }
 
 // Set the matrix to a matrix that rotates around the x-axis
-func (m *Mat3) RotationX(radians float32) {
+// Integer matrices get the sine and cosine truncated.
+func (m *Mat3Of[T]) RotationX(radians T) {
 	m[0] = 1.0
 	m[1] = 0.0
 	m[2] = 0.0
 
 	m[3] = 0.0
-	m[4] = Fcos32(radians)
-	m[5] = Fsin32(radians)
+	m[4] = cosOf(radians)
+	m[5] = sinOf(radians)
 
 	m[6] = 0.0
-	m[7] = -Fsin32(radians)
-	m[8] = Fcos32(radians)
+	m[7] = -sinOf(radians)
+	m[8] = cosOf(radians)
 }
 
 // Set the matrix to a matrix that rotates around the y-axis
-func (m *Mat3) RotationY(radians float32) {
-	m[0] = Fcos32(radians)
+// Integer matrices get the sine and cosine truncated.
+func (m *Mat3Of[T]) RotationY(radians T) {
+	m[0] = cosOf(radians)
 	m[1] = 0.0
-	m[2] = -Fsin32(radians)
+	m[2] = -sinOf(radians)
 
 	m[3] = 0.0
 	m[4] = 1.0
 	m[5] = 0.0
 
-	m[6] = Fsin32(radians)
+	m[6] = sinOf(radians)
 	m[7] = 0.0
-	m[8] = Fcos32(radians)
+	m[8] = cosOf(radians)
 }
 
 // Set the matrix to a matrix that rotates around the z-axis
-func (m *Mat3) RotationZ(radians float32) {
-	m[0] = Fcos32(radians)
-	m[1] = Fsin32(radians)
+// Integer matrices get the sine and cosine truncated.
+func (m *Mat3Of[T]) RotationZ(radians T) {
+	m[0] = cosOf(radians)
+	m[1] = sinOf(radians)
 	m[2] = 0.0
 
-	m[3] = -Fsin32(radians)
-	m[4] = Fcos32(radians)
+	m[3] = -sinOf(radians)
+	m[4] = cosOf(radians)
 	m[5] = 0.0
 
 	m[6] = 0.0
@@ -197,24 +207,25 @@ func (m *Mat3) RotationZ(radians float32) {
 }
 
 // Sets the matrix to a matrix that rotates with the help of the given quaternion
-func (m *Mat3) RotationQuaternion(pIn *Quaternion) {
+func (m *Mat3Of[T]) RotationQuaternion(pIn *QuaternionOf[T]) {
 	m[0] = 1.0 - 2.0*(pIn.Y*pIn.Y+pIn.Z*pIn.Z)
-	m[1] = 2.0 * (pIn.X*pIn.Y - pIn.W*pIn.Z)
-	m[2] = 2.0 * (pIn.X*pIn.Z + pIn.W*pIn.Y)
+	m[1] = 2.0 * (pIn.X*pIn.Y + pIn.W*pIn.Z)
+	m[2] = 2.0 * (pIn.X*pIn.Z - pIn.W*pIn.Y)
 
-	m[3] = 2.0 * (pIn.X*pIn.Y + pIn.W*pIn.Z)
+	m[3] = 2.0 * (pIn.X*pIn.Y - pIn.W*pIn.Z)
 	m[4] = 1.0 - 2.0*(pIn.X*pIn.X+pIn.Z*pIn.Z)
-	m[5] = 2.0 * (pIn.Y*pIn.Z - pIn.W*pIn.X)
+	m[5] = 2.0 * (pIn.Y*pIn.Z + pIn.W*pIn.X)
 
-	m[6] = 2.0 * (pIn.X*pIn.Z - pIn.W*pIn.Y)
-	m[7] = 2.0 * (pIn.Y*pIn.Z + pIn.W*pIn.X)
+	m[6] = 2.0 * (pIn.X*pIn.Z + pIn.W*pIn.Y)
+	m[7] = 2.0 * (pIn.Y*pIn.Z - pIn.W*pIn.X)
 	m[8] = 1.0 - 2.0*(pIn.X*pIn.X+pIn.Y*pIn.Y)
 }
 
-// Sets the matrix to a matrix that rotates with the help of the given vector Vec3 and angle float32
-func (m *Mat3) RotationAxisAngle(axis Vec3, radians float32) {
-	rcos := Fcos32(radians)
-	rsin := Fsin32(radians)
+// Sets the matrix to a matrix that rotates with the help of the given vector Vec3 and angle
+// Integer matrices get the sine and cosine truncated.
+func (m *Mat3Of[T]) RotationAxisAngle(axis Vec3Of[T], radians T) {
+	rcos := cosOf(radians)
+	rsin := sinOf(radians)
 
 	axis.Normalize()
 
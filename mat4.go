@@ -1,10 +1,18 @@
 package mathgl
 
+import "math"
+
 // 4x4 Matrix type. Column major.
-type Mat4 [16]float32
+type Mat4Of[T Number] [16]T
+
+// Matrices with float32 and float64 elements.
+type (
+	Mat4  = Mat4Of[float32]
+	Mat4d = Mat4Of[float64]
+)
 
 // Sets the matrix to a 3x3 identity matrix.
-func (m *Mat4) Identity() {
+func (m *Mat4Of[T]) Identity() {
 	m[0] = 1
 	m[1] = 0
 	m[2] = 0
@@ -26,16 +34,16 @@ func (m *Mat4) Identity() {
 	m[15] = 1
 }
 
-// Fills the matrix with the given float32.
-func (m *Mat4) Fill(content float32) {
+// Fills the matrix with the given value.
+func (m *Mat4Of[T]) Fill(content T) {
 	for i := range m {
 		m[i] = content
 	}
 }
 
-// Returns the calculated determinant from the matrix as float32.
-func (m *Mat4) Determinant() float32 {
-	var determinant float32
+// Returns the calculated determinant from the matrix.
+func (m *Mat4Of[T]) Determinant() T {
+	var determinant T
 
 	determinant = m[12]*m[9]*m[6]*m[3] - m[8]*m[13]*m[6]*m[3] - m[12]*m[5]*m[10]*m[3] + m[4]*m[13]*m[10]*m[3] +
 		m[8]*m[5]*m[14]*m[3] - m[4]*m[9]*m[14]*m[3] - m[12]*m[9]*m[2]*m[7] + m[8]*m[13]*m[2]*m[7] +
@@ -47,17 +55,17 @@ func (m *Mat4) Determinant() float32 {
 }
 
 // Returns the item at the given row and column
-func (m *Mat4) get(row, col int) float32 {
+func (m *Mat4Of[T]) get(row, col int) T {
 	return m[row+4*col]
 }
 
 // Sets the item at the given row and column
-func (m *Mat4) set(row, col int, value float32) {
+func (m *Mat4Of[T]) set(row, col int, value T) {
 	m[row+4*col] = value
 }
 
 // Swaps the given items at the given locations
-func (m *Mat4) swap(r1, c1, r2, c2 int) {
+func (m *Mat4Of[T]) swap(r1, c1, r2, c2 int) {
 	tmp := m.get(r1, c1)
 	m.set(r1, c1, m.get(r2, c2))
 	m.set(r2, c2, tmp)
@@ -65,10 +73,10 @@ func (m *Mat4) swap(r1, c1, r2, c2 int) {
 
 
 //Returns an upper and a lower triangular matrix which are L and R in the Gauss algorithm
-func gaussj(a, b *Mat4) bool {
+func gaussj[T Number](a, b *Mat4Of[T]) bool {
 	var i, j, k, l, ll, icol, irow int
 	var n, m int = 4, 4
-	var big, dum, pivinv float32
+	var big, dum, pivinv T
 	var indxc [4]int
 	var indxr [4]int
 	var ipiv [4]int
@@ -79,8 +87,8 @@ func gaussj(a, b *Mat4) bool {
 			if ipiv[j] != 1 {
 				for k = 0; k < n; k++ {
 					if ipiv[k] == 0 {
-						if Fabs32(a.get(j, k)) >= big {
-							big = Fabs32(a.get(j, k))
+						if absOf(a.get(j, k)) >= big {
+							big = absOf(a.get(j, k))
 							irow = j
 							icol = k
 						}
@@ -137,9 +145,10 @@ func gaussj(a, b *Mat4) bool {
 	return true
 }
 
-// Inverse the matrix with the given determinant in float32. Returns true if the inverse could be build.
-func (m *Mat4) Inverse() bool {
-	var inv, tmp Mat4
+// Inverse the matrix with the given determinant. Returns true if the inverse could be build.
+// Integer matrices truncate every division of the elimination.
+func (m *Mat4Of[T]) Inverse() bool {
+	var inv, tmp Mat4Of[T]
 	inv.Assign(m)
 	tmp.Identity()
 
@@ -153,8 +162,8 @@ func (m *Mat4) Inverse() bool {
 
 
 // Returns true if the matrix is a identity matrix.
-func (m *Mat4) IsIdentity() bool {
-	var identity Mat4
+func (m *Mat4Of[T]) IsIdentity() bool {
+	var identity Mat4Of[T]
 	identity.Identity()
 	if m.AreEqual(&identity) {
 		return true
@@ -163,8 +172,8 @@ func (m *Mat4) IsIdentity() bool {
 }
 
 // Transpose the matrix
-func (m *Mat4) Transpose() {
-	var tmp Mat4
+func (m *Mat4Of[T]) Transpose() {
+	var tmp Mat4Of[T]
 	for z := 0; z < 4; z++ {
 		for x := 0; x < 4; x++ {
 			tmp[(z*4)+x] = m[(x*4)+z]
@@ -174,8 +183,8 @@ func (m *Mat4) Transpose() {
 }
 
 // Multiplies the matrix with a given Mat4 matrix
-func (m *Mat4) Multiply(in *Mat4) {
-	var out Mat4
+func (m *Mat4Of[T]) Multiply(in *Mat4Of[T]) {
+	var out Mat4Of[T]
 
 	// TODO: Anybody want to write some SSE code for the AMD64?
 	out[0] = m[0]*in[0] + m[4]*in[1] + m[8]*in[2] + m[12]*in[3]
@@ -201,33 +210,32 @@ func (m *Mat4) Multiply(in *Mat4) {
 	*m = out
 }
 
-// Multiplies the matrix with a given scalar in float32.
-func (m *Mat4) ScalarMultiply(factor float32) {
+// Multiplies the matrix with a given scalar.
+func (m *Mat4Of[T]) ScalarMultiply(factor T) {
 	for i := range m {
 		m[i] = m[i] * factor
 	}
 }
 
 // Assigns the values of the input matrix
-func (m *Mat4) Assign(input *Mat4) {
+func (m *Mat4Of[T]) Assign(input *Mat4Of[T]) {
 	for i, x := range input {
 		m[i] = x
 	}
 }
 
 // Returns true if the 2 matrices are equal (approximately)
-func (m *Mat4) AreEqual(candidate *Mat4) bool {
+func (m *Mat4Of[T]) AreEqual(candidate *Mat4Of[T]) bool {
 	for i, x := range candidate {
-		if !(m[i]+epsilon > x &&
-			m[i]-epsilon < x) {
+		if !almostEqual(m[i], x) {
 			return false
 		}
 	}
 	return true
 }
 
-// Set the matrix to a scaling matrix, which scale with given x,y floats32
-func (m *Mat4) Scaling(x, y, z float32) {
+// Set the matrix to a scaling matrix, which scale with given x,y
+func (m *Mat4Of[T]) Scaling(x, y, z T) {
 	m.Identity()
 	m[0] = x
 	m[5] = y
@@ -235,8 +243,8 @@ func (m *Mat4) Scaling(x, y, z float32) {
 }
 
 
-// Set the matrix to a translation matrix, which translates with given x,y floats32
-func (m *Mat4) Translation(x, y, z float32) {
+// Set the matrix to a translation matrix, which translates with given x,y
+func (m *Mat4Of[T]) Translation(x, y, z T) {
 	m.Identity()
 	m[12] = x
 	m[13] = y
@@ -244,20 +252,21 @@ func (m *Mat4) Translation(x, y, z float32) {
 }
 
 // Set the matrix to a matrix that rotates around the x-axis
-func (m *Mat4) RotationX(radians float32) {
+// Integer matrices get the sine and cosine truncated.
+func (m *Mat4Of[T]) RotationX(radians T) {
 	m[0] = 1.0
 	m[1] = 0.0
 	m[2] = 0.0
 	m[3] = 0.0
 
 	m[4] = 0.0
-	m[5] = Fcos32(radians)
-	m[6] = Fsin32(radians)
+	m[5] = cosOf(radians)
+	m[6] = sinOf(radians)
 	m[7] = 0.0
 
 	m[8] = 0.0
-	m[9] = -Fsin32(radians)
-	m[10] = Fcos32(radians)
+	m[9] = -sinOf(radians)
+	m[10] = cosOf(radians)
 	m[11] = 0.0
 
 	m[12] = 0.0
@@ -267,10 +276,11 @@ func (m *Mat4) RotationX(radians float32) {
 }
 
 // Set the matrix to a matrix that rotates around the y-axis
-func (m *Mat4) RotationY(radians float32) {
-	m[0] = Fcos32(radians)
+// Integer matrices get the sine and cosine truncated.
+func (m *Mat4Of[T]) RotationY(radians T) {
+	m[0] = cosOf(radians)
 	m[1] = 0.0
-	m[2] = -Fsin32(radians)
+	m[2] = -sinOf(radians)
 	m[3] = 0.0
 
 	m[4] = 0.0
@@ -278,9 +288,9 @@ func (m *Mat4) RotationY(radians float32) {
 	m[6] = 0.0
 	m[7] = 0.0
 
-	m[8] = Fsin32(radians)
+	m[8] = sinOf(radians)
 	m[9] = 0.0
-	m[10] = Fcos32(radians)
+	m[10] = cosOf(radians)
 	m[11] = 0.0
 
 	m[12] = 0.0
@@ -290,14 +300,15 @@ func (m *Mat4) RotationY(radians float32) {
 }
 
 // Set the matrix to a matrix that rotates around the z-axis
-func (m *Mat4) RotationZ(radians float32) {
-	m[0] = Fcos32(radians)
-	m[1] = Fsin32(radians)
+// Integer matrices get the sine and cosine truncated.
+func (m *Mat4Of[T]) RotationZ(radians T) {
+	m[0] = cosOf(radians)
+	m[1] = sinOf(radians)
 	m[2] = 0.0
 	m[3] = 0.0
 
-	m[4] = -Fsin32(radians)
-	m[5] = Fcos32(radians)
+	m[4] = -sinOf(radians)
+	m[5] = cosOf(radians)
 	m[6] = 0.0
 	m[7] = 0.0
 
@@ -313,19 +324,19 @@ func (m *Mat4) RotationZ(radians float32) {
 }
 
 // Sets the matrix to a matrix that rotates with the help of the given quaternion
-func (m *Mat4) RotationQuaternion(pIn *Quaternion) {
+func (m *Mat4Of[T]) RotationQuaternion(pIn *QuaternionOf[T]) {
 	m[0] = 1.0 - 2.0*(pIn.Y*pIn.Y+pIn.Z*pIn.Z)
-	m[1] = 2.0 * (pIn.X*pIn.Y - pIn.W*pIn.Z)
-	m[2] = 2.0 * (pIn.X*pIn.Z + pIn.W*pIn.Y)
+	m[1] = 2.0 * (pIn.X*pIn.Y + pIn.W*pIn.Z)
+	m[2] = 2.0 * (pIn.X*pIn.Z - pIn.W*pIn.Y)
 	m[3] = 0.0
 
-	m[4] = 2.0 * (pIn.X*pIn.Y + pIn.W*pIn.Z)
+	m[4] = 2.0 * (pIn.X*pIn.Y - pIn.W*pIn.Z)
 	m[5] = 1.0 - 2.0*(pIn.X*pIn.X+pIn.Z*pIn.Z)
-	m[6] = 2.0 * (pIn.Y*pIn.Z - pIn.W*pIn.X)
+	m[6] = 2.0 * (pIn.Y*pIn.Z + pIn.W*pIn.X)
 	m[7] = 0.0
 
-	m[8] = 2.0 * (pIn.X*pIn.Z - pIn.W*pIn.Y)
-	m[9] = 2.0 * (pIn.Y*pIn.Z + pIn.W*pIn.X)
+	m[8] = 2.0 * (pIn.X*pIn.Z + pIn.W*pIn.Y)
+	m[9] = 2.0 * (pIn.Y*pIn.Z - pIn.W*pIn.X)
 	m[10] = 1.0 - 2.0*(pIn.X*pIn.X+pIn.Y*pIn.Y)
 	m[11] = 0.0
 
@@ -335,10 +346,11 @@ func (m *Mat4) RotationQuaternion(pIn *Quaternion) {
 	m[15] = 1.0
 }
 
-// Sets the matrix to a matrix that rotates with the help of the given vector Vec3 and angle float32
-func (m *Mat4) RotationAxisAngle(axis Vec3, radians float32) {
-	rcos := Fcos32(radians)
-	rsin := Fsin32(radians)
+// Sets the matrix to a matrix that rotates with the help of the given vector Vec3 and angle
+// Integer matrices get the sine and cosine truncated.
+func (m *Mat4Of[T]) RotationAxisAngle(axis Vec3Of[T], radians T) {
+	rcos := cosOf(radians)
+	rsin := sinOf(radians)
 
 	axis.Normalize()
 
@@ -364,13 +376,14 @@ func (m *Mat4) RotationAxisAngle(axis Vec3, radians float32) {
 }
 
 // Sets the matrix to a rotation matrix from pitch, yaw and roll.
-func (m *Mat4) RotationPitchYawRoll(pitch, yaw, roll float32) {
-	cr := Fcos32(pitch)
-	sr := Fsin32(pitch)
-	cp := Fcos32(yaw)
-	sp := Fsin32(yaw)
-	cy := Fcos32(roll)
-	sy := Fsin32(roll)
+// Integer matrices get the sine and cosine truncated.
+func (m *Mat4Of[T]) RotationPitchYawRoll(pitch, yaw, roll T) {
+	cr := cosOf(pitch)
+	sr := sinOf(pitch)
+	cp := cosOf(yaw)
+	sp := sinOf(yaw)
+	cy := cosOf(roll)
+	sy := sinOf(roll)
 	srsp := sr * sp
 	crsp := cr * sp
 
@@ -396,8 +409,8 @@ func (m *Mat4) RotationPitchYawRoll(pitch, yaw, roll float32) {
 }
 
 // Get the up vector from a 4x4 matrix.
-func (m *Mat4) GetUpVec3() *Vec3 {
-	var v Vec3
+func (m *Mat4Of[T]) GetUpVec3() *Vec3Of[T] {
+	var v Vec3Of[T]
 	v.X = m[4]
 	v.Y = m[5]
 	v.Z = m[6]
@@ -407,8 +420,8 @@ func (m *Mat4) GetUpVec3() *Vec3 {
 }
 
 // Get the right vector from a 4x4 matrix.
-func (m *Mat4) GetRightVec3() *Vec3 {
-	var v Vec3
+func (m *Mat4Of[T]) GetRightVec3() *Vec3Of[T] {
+	var v Vec3Of[T]
 	v.X = m[0]
 	v.Y = m[1]
 	v.Z = m[2]
@@ -418,8 +431,8 @@ func (m *Mat4) GetRightVec3() *Vec3 {
 }
 
 // Get the forward vector from a 4x4 matrix.
-func (m *Mat4) GetForwardVec3() *Vec3 {
-	var v Vec3
+func (m *Mat4Of[T]) GetForwardVec3() *Vec3Of[T] {
+	var v Vec3Of[T]
 	v.X = m[8]
 	v.Y = m[9]
 	v.Z = m[10]
@@ -429,8 +442,8 @@ func (m *Mat4) GetForwardVec3() *Vec3 {
 }
 
 // Extract a 3x3 rotation matrix from the input 4x4 transformation.
-func (m *Mat4) ExtractRotation() *Mat3 {
-	var out Mat3
+func (m *Mat4Of[T]) ExtractRotation() *Mat3Of[T] {
+	var out Mat3Of[T]
 	out[0] = m[0]
 	out[1] = m[1]
 	out[2] = m[2]
@@ -447,15 +460,15 @@ func (m *Mat4) ExtractRotation() *Mat3 {
 }
 
 // Take the rotation from a 4x4 transformation matrix, and return it as an axis and an angle (in radians)
-func (m *Mat4) RotationToAxisAngle() (*Vec3, float32) {
-	var temp Quaternion
+func (m *Mat4Of[T]) RotationToAxisAngle() (*Vec3Of[T], T) {
+	var temp QuaternionOf[T]
 	rotation := m.ExtractRotation()
 	temp.RotationMatrix(rotation)
 	return temp.QuaternionToAxisAngle()
 }
 
 // Sets the matrix to a transformation matrix using a 3x3 rotation matrix and a 3d vector representing a translation.
-func (m *Mat4) RotationTranslation(rotation *Mat3, translation *Vec3) {
+func (m *Mat4Of[T]) RotationTranslation(rotation *Mat3Of[T], translation *Vec3Of[T]) {
 	m[0] = rotation[0]
 	m[1] = rotation[1]
 	m[2] = rotation[2]
@@ -477,9 +490,168 @@ func (m *Mat4) RotationTranslation(rotation *Mat3, translation *Vec3) {
 	m[15] = 1.0
 }
 
-func (m *Mat4) kmMat4ExtractPlane(planeType PlaneEnum) *Plane {
-	var t float32 = 1.0
-	var plane Plane
+// Returns the normalized side, up and forward vectors of a camera looking
+// into the given direction.
+func lookBasis[T Number](forward, up *Vec3Of[T]) (s, u, f Vec3Of[T]) {
+	f.Assign(forward)
+	f.Normalize()
+
+	s.Assign(&f)
+	s.Cross(up)
+	s.Normalize()
+
+	u.Assign(&s)
+	u.Cross(&f)
+	return
+}
+
+// Sets the matrix to a view matrix like gluLookAt, which transforms world
+// coordinates into the coordinates of a camera at eye looking at center.
+// Integer matrices normalize the axes like Vec3Of.SafeNormalized does.
+func (m *Mat4Of[T]) LookAt(eye, center, up *Vec3Of[T]) {
+	var forward Vec3Of[T]
+	forward.Assign(center)
+	forward.Subtract(eye)
+	s, u, f := lookBasis(&forward, up)
+
+	m[0] = s.X
+	m[1] = u.X
+	m[2] = -f.X
+	m[3] = 0.0
+
+	m[4] = s.Y
+	m[5] = u.Y
+	m[6] = -f.Y
+	m[7] = 0.0
+
+	m[8] = s.Z
+	m[9] = u.Z
+	m[10] = -f.Z
+	m[11] = 0.0
+
+	m[12] = -s.Dot(eye)
+	m[13] = -u.Dot(eye)
+	m[14] = f.Dot(eye)
+	m[15] = 1.0
+}
+
+// Sets the matrix to the inverse of LookAt, which transforms camera
+// coordinates into world coordinates (the camera's model matrix).
+// Integer matrices normalize the axes like Vec3Of.SafeNormalized does.
+func (m *Mat4Of[T]) InverseLookAt(eye, center, up *Vec3Of[T]) {
+	var forward Vec3Of[T]
+	forward.Assign(center)
+	forward.Subtract(eye)
+	s, u, f := lookBasis(&forward, up)
+
+	m[0] = s.X
+	m[1] = s.Y
+	m[2] = s.Z
+	m[3] = 0.0
+
+	m[4] = u.X
+	m[5] = u.Y
+	m[6] = u.Z
+	m[7] = 0.0
+
+	m[8] = -f.X
+	m[9] = -f.Y
+	m[10] = -f.Z
+	m[11] = 0.0
+
+	m[12] = eye.X
+	m[13] = eye.Y
+	m[14] = eye.Z
+	m[15] = 1.0
+}
+
+// Sets the matrix to a perspective projection matrix like gluPerspective. The
+// field of view fovy is given in degrees, near and far are the (positive)
+// distances to the clipping planes.
+// Integer matrices truncate the divisions.
+func (m *Mat4Of[T]) Perspective(fovy, aspect, near, far T) {
+	// A variable, unsigned element types can't hold the constant -1
+	one := T(1)
+	f := 1.0 / T(math.Tan(float64(deg2radOf(fovy)/2.0)))
+	depth := near - far
+
+	m.Fill(0.0)
+	m[0] = f / aspect
+	m[5] = f
+	m[10] = (far + near) / depth
+	m[11] = -one
+	m[14] = 2.0 * far * near / depth
+}
+
+// Sets the matrix to a perspective projection matrix with the far clipping
+// plane at infinity. The field of view fovy is given in degrees.
+// Integer matrices truncate the divisions.
+func (m *Mat4Of[T]) InfinitePerspective(fovy, aspect, near T) {
+	one := T(1)
+	f := 1.0 / T(math.Tan(float64(deg2radOf(fovy)/2.0)))
+
+	m.Fill(0.0)
+	m[0] = f / aspect
+	m[5] = f
+	m[10] = -one
+	m[11] = -one
+	m[14] = -(2.0 * near)
+}
+
+// Sets the matrix to a reversed-Z perspective projection matrix with the far
+// clipping plane at infinity. The near plane is mapped to a depth of 1 and
+// infinity to 0, so it expects a 0..1 clip space depth range (as set up by
+// glClipControl(GL_LOWER_LEFT, GL_ZERO_TO_ONE)) and a GL_GREATER depth test.
+// The field of view fovy is given in degrees.
+// Integer matrices truncate the divisions.
+func (m *Mat4Of[T]) ReversedInfinitePerspective(fovy, aspect, near T) {
+	one := T(1)
+	f := 1.0 / T(math.Tan(float64(deg2radOf(fovy)/2.0)))
+
+	m.Fill(0.0)
+	m[0] = f / aspect
+	m[5] = f
+	m[11] = -one
+	m[14] = near
+}
+
+// Sets the matrix to a perspective projection matrix like glFrustum.
+// Integer matrices truncate the divisions.
+func (m *Mat4Of[T]) Frustum(left, right, bottom, top, near, far T) {
+	one := T(1)
+	m.Fill(0.0)
+	m[0] = 2.0 * near / (right - left)
+	m[5] = 2.0 * near / (top - bottom)
+	m[8] = (right + left) / (right - left)
+	m[9] = (top + bottom) / (top - bottom)
+	m[10] = -(far + near) / (far - near)
+	m[11] = -one
+	m[14] = -(2.0 * far * near / (far - near))
+}
+
+// Sets the matrix to an orthographic projection matrix like glOrtho.
+// Integer matrices truncate the divisions.
+func (m *Mat4Of[T]) Ortho(left, right, bottom, top, near, far T) {
+	m.Identity()
+	m[0] = 2.0 / (right - left)
+	m[5] = 2.0 / (top - bottom)
+	m[10] = -(2.0 / (far - near))
+	m[12] = -(right + left) / (right - left)
+	m[13] = -(top + bottom) / (top - bottom)
+	m[14] = -(far + near) / (far - near)
+}
+
+// Sets the matrix to a 2D orthographic projection matrix like gluOrtho2D.
+func (m *Mat4Of[T]) Ortho2D(left, right, bottom, top T) {
+	one := T(1)
+	m.Ortho(left, right, bottom, top, -one, one)
+}
+
+// Returns the given normalized frustum plane of a projection (or
+// view-projection) matrix. The plane normals point into the frustum.
+func (m *Mat4Of[T]) ExtractPlane(planeType PlaneEnum) *PlaneOf[T] {
+	var t T = 1.0
+	var plane PlaneOf[T]
 
 	switch planeType {
 	case PLANE_RIGHT:
@@ -522,7 +694,11 @@ func (m *Mat4) kmMat4ExtractPlane(planeType PlaneEnum) *Plane {
 		panic("Invalid plane type given!")
 	}
 
-	t = Fsqrt32(plane.A*plane.A + plane.B*plane.B + plane.C*plane.C)
+	t = sqrtOf(plane.A*plane.A + plane.B*plane.B + plane.C*plane.C)
+	if t == 0.0 {
+		// The plane is at infinity (e.g. the far plane of InfinitePerspective).
+		return &plane
+	}
 	plane.A /= t
 	plane.B /= t
 	plane.C /= t
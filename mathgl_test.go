@@ -2,6 +2,9 @@ package mathgl
 
 import (
 	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
 	"testing"
 )
 
@@ -151,5 +154,1912 @@ func TestPolyClipping(t *testing.T) {
 	p = append(p, Vec2{1, 2})
 	p = append(p, Vec2{3, 5})
 	p = append(p, Vec2{6, 0})
-	p.Clip(&Seg2{Vec2{2,0}, Vec2{2,10}})
+	if err := p.Clip(&Seg2{Vec2{2,0}, Vec2{2,10}}); err != nil {
+		t.Errorf("Clipping a convex clockwise polygon fails with %v", err)
+	}
+
+	ccw := Poly{{0, 0}, {1, 0}, {1, 1}, {0, 1}}
+	if err := ccw.Clip(&Seg2{Vec2{0.5, 0}, Vec2{0.5, 1}}); err != ErrPolyCounterClockwise {
+		t.Errorf("Clipping a counter-clockwise polygon should fail with ErrPolyCounterClockwise but gives %v", err)
+	}
+	bowtie := Poly{{0, 0}, {1, 1}, {1, 0}, {0, 1}, {-1, 1}}
+	if err := bowtie.Clip(&Seg2{Vec2{0.5, 0}, Vec2{0.5, 1}}); err == nil {
+		t.Errorf("Clipping a self-intersecting polygon should fail")
+	}
+	concave := Poly{{0, 0}, {0, 2}, {1, 1}, {2, 2}, {2, 0}}
+	if err := concave.Clip(&Seg2{Vec2{0.5, 0}, Vec2{0.5, 2}}); err != ErrPolyNotConvex {
+		t.Errorf("Clipping a concave polygon should fail with ErrPolyNotConvex but gives %v", err)
+	}
+}
+
+func TestQuaternion(t *testing.T) {
+	axis := Vec3{1.0, 2.0, 3.0}
+	var radians float32 = 1.2
+
+	var q Quaternion
+	q.RotationAxisAngle(axis, radians)
+	if l := q.Length(); !FalmostEqual32(l, 1.0) {
+		t.Errorf("Quaternion built from axis and angle is not a unit quaternion, length is %f", l)
+	}
+
+	// The quaternion and the axis angle matrix must describe the same rotation
+	var fromQuat, fromAxis Mat4
+	fromQuat.RotationQuaternion(&q)
+	fromAxis.RotationAxisAngle(axis, radians)
+	if !fromQuat.AreEqual(&fromAxis) {
+		t.Errorf("Mat4.RotationQuaternion %v differs from Mat4.RotationAxisAngle %v", fromQuat, fromAxis)
+	}
+
+	// Round trip: quaternion -> matrix -> quaternion
+	var r Quaternion
+	r.RotationMat4(&fromQuat)
+	if !r.AreEqual(&q) {
+		t.Errorf("Quaternion %v did not survive a round trip through Mat4, got %v", &q, &r)
+	}
+	var m3 Mat3
+	m3.RotationQuaternion(&q)
+	r.RotationMatrix(&m3)
+	if !r.AreEqual(&q) {
+		t.Errorf("Quaternion %v did not survive a round trip through Mat3, got %v", &q, &r)
+	}
+
+	// Round trip: quaternion -> axis angle
+	resAxis, resAngle := q.QuaternionToAxisAngle()
+	axis.Normalize()
+	if !resAxis.AreEqual(&axis) || !FalmostEqual32(resAngle, radians) {
+		t.Errorf("Expected axis %v and angle %f, got %v and %f", &axis, radians, resAxis, resAngle)
+	}
+	resAxis, resAngle = fromAxis.RotationToAxisAngle()
+	if resAxis == nil || !resAxis.AreEqual(&axis) || !FalmostEqual32(resAngle, radians) {
+		t.Errorf("Mat4.RotationToAxisAngle returned axis %v and angle %f", resAxis, resAngle)
+	}
+
+	// Rotating a vector must match the matrix transformation
+	v := Vec3{4.0, -1.0, 0.5}
+	w := v
+	v.Rotate(&q)
+	w.Transform(&fromQuat)
+	if !v.AreEqual(&w) {
+		t.Errorf("Vec3.Rotate gave %v, Vec3.Transform gave %v", &v, &w)
+	}
+
+	// Quaternion multiplication must match matrix multiplication
+	var p Quaternion
+	p.RotationAxisAngle(Vec3{0.0, 1.0, 0.0}, -0.7)
+	var pm Mat4
+	pm.RotationQuaternion(&p)
+	pq := p
+	pq.Multiply(&q)
+	pm.Multiply(&fromQuat)
+	var pqm Mat4
+	pqm.RotationQuaternion(&pq)
+	if !pqm.AreEqual(&pm) {
+		t.Errorf("Quaternion product gives matrix %v, matrix product is %v", pqm, pm)
+	}
+
+	// A quaternion multiplied with its inverse is the identity
+	inv := q
+	if !inv.Inverse() {
+		t.Errorf("Could not invert quaternion %v", &q)
+	}
+	inv.Multiply(&q)
+	if !inv.IsIdentity() {
+		t.Errorf("Quaternion times its inverse is %v, not the identity", &inv)
+	}
+
+	// Matrices close to a half turn take the other branches of the conversion
+	for _, a := range []Vec3{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}} {
+		var half Quaternion
+		var hm Mat3
+		hm.RotationAxisAngle(a, 3.0)
+		half.RotationMatrix(&hm)
+		var back Mat3
+		back.RotationQuaternion(&half)
+		if !back.AreEqual(&hm) {
+			t.Errorf("Rotation matrix %v became %v after a round trip through a quaternion", hm, back)
+		}
+	}
+}
+
+func TestQuaternionInterpolation(t *testing.T) {
+	var a, b, q Quaternion
+	axis := Vec3{0.0, 0.0, 1.0}
+	a.RotationAxisAngle(axis, 0.0)
+	b.RotationAxisAngle(axis, 1.0)
+
+	q.Slerp(&a, &b, 0.0)
+	if !q.AreEqual(&a) {
+		t.Errorf("Slerp at 0 should be %v but is %v", &a, &q)
+	}
+	q.Slerp(&a, &b, 1.0)
+	if !q.AreEqual(&b) {
+		t.Errorf("Slerp at 1 should be %v but is %v", &b, &q)
+	}
+
+	var expected Quaternion
+	expected.RotationAxisAngle(axis, 0.25)
+	q.Slerp(&a, &b, 0.25)
+	if !q.AreEqual(&expected) || !FalmostEqual32(q.Length(), 1.0) {
+		t.Errorf("Slerp at 0.25 should be %v but is %v", &expected, &q)
+	}
+
+	// -b is the same rotation as b, Slerp has to take the short way around
+	negB := b
+	negB.Scale(-1.0)
+	q.Slerp(&a, &negB, 0.25)
+	if q.Dot(&expected) < 0.99 {
+		t.Errorf("Slerp did not take the shortest path, got %v", &q)
+	}
+	q.Nlerp(&a, &negB, 0.5)
+	expected.RotationAxisAngle(axis, 0.5)
+	if q.Dot(&expected) < 0.99 || !FalmostEqual32(q.Length(), 1.0) {
+		t.Errorf("Nlerp at 0.5 should be %v but is %v", &expected, &q)
+	}
+
+	// Nearly parallel quaternions use the linear fallback
+	var c Quaternion
+	c.RotationAxisAngle(axis, 0.0001)
+	q.Slerp(&a, &c, 0.5)
+	if q.X != q.X || !FalmostEqual32(q.Length(), 1.0) {
+		t.Errorf("Slerp of nearly parallel quaternions gave %v", &q)
+	}
+
+	// Evenly spaced keys around one axis make Squad follow Slerp
+	keys := make([]Quaternion, 4)
+	for i := range keys {
+		keys[i].RotationAxisAngle(axis, float32(i)*0.5)
+	}
+	tangents := SquadTangents(keys)
+	for _, step := range []float32{0.0, 0.3, 0.5, 1.0} {
+		var s Quaternion
+		q.Squad(&keys[1], &keys[2], &tangents[1], &tangents[2], step)
+		s.Slerp(&keys[1], &keys[2], step)
+		if !q.AreEqual(&s) || !FalmostEqual32(q.Length(), 1.0) {
+			t.Errorf("Squad at %f should be %v but is %v", step, &s, &q)
+		}
+	}
+
+	// Squad passes through its keys for arbitrary rotations
+	keys[0].RotationAxisAngle(Vec3{1, 0, 0}, 0.3)
+	keys[1].RotationAxisAngle(Vec3{0, 1, 0}, 1.1)
+	keys[2].RotationAxisAngle(Vec3{1, 1, 0}, 2.0)
+	keys[3].RotationAxisAngle(Vec3{0, 0, 1}, -0.4)
+	tangents = SquadTangents(keys)
+	q.Squad(&keys[1], &keys[2], &tangents[1], &tangents[2], 0.0)
+	if !q.AreEqual(&keys[1]) {
+		t.Errorf("Squad at 0 should be %v but is %v", &keys[1], &q)
+	}
+	q.Squad(&keys[1], &keys[2], &tangents[1], &tangents[2], 1.0)
+	if !q.AreEqual(&keys[2]) {
+		t.Errorf("Squad at 1 should be %v but is %v", &keys[2], &q)
+	}
+	q.Squad(&keys[1], &keys[2], &tangents[1], &tangents[2], 0.6)
+	if !FalmostEqual32(q.Length(), 1.0) {
+		t.Errorf("Squad result %v is not a unit quaternion", &q)
+	}
+
+	// Nearly opposite keys still go the long way without the shortest path
+	a.RotationAxisAngle(axis, 0.0)
+	b.RotationAxisAngle(axis, 2*math.Pi-0.01)
+	q.slerp(&a, &b, 1.0)
+	if !q.AreEqual(&b) {
+		t.Errorf("slerp of nearly opposite keys at 1 should be %v but is %v", &b, &q)
+	}
+}
+
+func TestMat4Projection(t *testing.T) {
+	var p, f Mat4
+	p.Perspective(90.0, 2.0, 1.0, 100.0)
+	f.Frustum(-2.0, 2.0, -1.0, 1.0, 1.0, 100.0)
+	if !p.AreEqual(&f) {
+		t.Errorf("Perspective %v and Frustum %v should be equal", p, f)
+	}
+
+	// The near and far planes are mapped to -1 and 1
+	near := Vec3{2.0, 1.0, -1.0}
+	near.TransformCoord(&p)
+	expected := Vec3{1.0, 1.0, -1.0}
+	if !near.AreEqual(&expected) {
+		t.Errorf("Near top right corner should be %v but is %v", &expected, &near)
+	}
+	far := Vec3{0.0, 0.0, -100.0}
+	far.TransformCoord(&p)
+	if !FalmostEqual32(far.Z, 1.0) {
+		t.Errorf("Far plane should map to depth 1 but maps to %f", far.Z)
+	}
+
+	var o Mat4
+	o.Ortho(0.0, 800.0, 0.0, 600.0, 1.0, 10.0)
+	corner := Vec3{800.0, 600.0, -10.0}
+	corner.TransformCoord(&o)
+	expected.Fill(1.0, 1.0, 1.0)
+	if !corner.AreEqual(&expected) {
+		t.Errorf("Ortho should map the far corner to %v but maps it to %v", &expected, &corner)
+	}
+	o.Ortho2D(0.0, 800.0, 0.0, 600.0)
+	corner.Fill(0.0, 0.0, 0.0)
+	corner.TransformCoord(&o)
+	expected.Fill(-1.0, -1.0, 0.0)
+	if !corner.AreEqual(&expected) {
+		t.Errorf("Ortho2D should map the origin to %v but maps it to %v", &expected, &corner)
+	}
+
+	// Infinite perspective keeps points far away inside the clip volume
+	var inf Mat4
+	inf.InfinitePerspective(60.0, 1.0, 0.5)
+	distant := Vec3{0.0, 0.0, -1e6}
+	distant.TransformCoord(&inf)
+	if distant.Z > 1.0 || distant.Z < 0.99 {
+		t.Errorf("Distant point should map close to depth 1 but maps to %f", distant.Z)
+	}
+	farPlane := inf.ExtractPlane(PLANE_FAR)
+	if farPlane.A != 0.0 || farPlane.B != 0.0 || farPlane.C != 0.0 || farPlane.D <= 0.0 {
+		t.Errorf("Far plane of an infinite projection should be at infinity, it is %v", farPlane)
+	}
+
+	inf.ReversedInfinitePerspective(60.0, 1.0, 0.5)
+	near.Fill(0.0, 0.0, -0.5)
+	near.TransformCoord(&inf)
+	distant.Fill(0.0, 0.0, -1e6)
+	distant.TransformCoord(&inf)
+	if !FalmostEqual32(near.Z, 1.0) || !FalmostEqual32(distant.Z, 0.0) {
+		t.Errorf("Reversed-Z should map near to 1 and infinity to 0, got %f and %f", near.Z, distant.Z)
+	}
+
+	// Plane extraction works on the projection matrices: with a 90 degree
+	// field of view the left plane is tilted by 45 degrees.
+	p.Perspective(90.0, 1.0, 1.0, 100.0)
+	left := p.ExtractPlane(PLANE_LEFT)
+	if !FalmostEqual32(left.A, 0.7071) || !FalmostEqual32(left.C, -0.7071) || !FalmostEqual32(left.D, 0.0) {
+		t.Errorf("Unexpected left plane %v", left)
+	}
+	nearPlane := p.ExtractPlane(PLANE_NEAR)
+	if !FalmostEqual32(nearPlane.C, -1.0) || !FalmostEqual32(nearPlane.D, -1.0) {
+		t.Errorf("Unexpected near plane %v", nearPlane)
+	}
+}
+
+func TestMat4LookAt(t *testing.T) {
+	eye := Vec3{1.0, 2.0, 5.0}
+	center := Vec3{1.0, 2.0, 0.0}
+	up := Vec3{0.0, 1.0, 0.0}
+
+	// Looking down the negative Z axis is a pure translation
+	var view, expected Mat4
+	view.LookAt(&eye, &center, &up)
+	expected.Translation(-1.0, -2.0, -5.0)
+	if !view.AreEqual(&expected) {
+		t.Errorf("LookAt should be %v but is %v", expected, view)
+	}
+
+	// The center ends up on the negative Z axis of the camera
+	center.Fill(4.0, -1.0, 2.0)
+	view.LookAt(&eye, &center, &up)
+	c := center
+	c.Transform(&view)
+	if !FalmostEqual32(c.X, 0.0) || !FalmostEqual32(c.Y, 0.0) || c.Z >= 0.0 {
+		t.Errorf("Center should be on the negative Z axis of the camera but is %v", &c)
+	}
+
+	var inverse Mat4
+	inverse.InverseLookAt(&eye, &center, &up)
+	inverse.Multiply(&view)
+	if !inverse.IsIdentity() {
+		t.Errorf("InverseLookAt times LookAt should be the identity but is %v", inverse)
+	}
+
+	// LookRotation matches the rotation of the camera-to-world transform
+	var forward Vec3
+	forward.Assign(&center)
+	forward.Subtract(&eye)
+	var q Quaternion
+	q.LookRotation(&forward, &up)
+	var rotation Mat4
+	rotation.RotationQuaternion(&q)
+	inverse.InverseLookAt(&eye, &center, &up)
+	inverse[12], inverse[13], inverse[14] = 0.0, 0.0, 0.0
+	if !rotation.AreEqual(&inverse) {
+		t.Errorf("LookRotation gives %v, expected %v", rotation, inverse)
+	}
+
+	minusZ := Vec3{0.0, 0.0, -1.0}
+	minusZ.Rotate(&q)
+	forward.Normalize()
+	if !minusZ.AreEqual(&forward) {
+		t.Errorf("LookRotation should turn -Z into %v but gives %v", &forward, &minusZ)
+	}
+}
+
+func TestProjectUnproject(t *testing.T) {
+	viewport := Viewport{0, 0, 800, 600}
+	var projection, view Mat4
+	projection.Perspective(60.0, 800.0/600.0, 1.0, 100.0)
+	eye := Vec3{3.0, 4.0, 10.0}
+	center := Vec3{0.0, 0.0, 0.0}
+	up := Vec3{0.0, 1.0, 0.0}
+	view.LookAt(&eye, &center, &up)
+
+	// The center of the view lands in the middle of the window
+	win := center
+	if !win.Project(&view, &projection, &viewport) {
+		t.Errorf("Could not project %v", &center)
+	}
+	if !FalmostEqual32(win.X, 400.0) || !FalmostEqual32(win.Y, 300.0) || win.Z <= 0.0 || win.Z >= 1.0 {
+		t.Errorf("Center should project to the middle of the window but projects to %v", &win)
+	}
+
+	point := Vec3{1.5, -0.5, 2.0}
+	p := point
+	p.Project(&view, &projection, &viewport)
+	if !p.Unproject(&view, &projection, &viewport) {
+		t.Errorf("Could not unproject %v", &p)
+	}
+	if !p.AreEqual(&point) {
+		t.Errorf("Project and Unproject should give %v but give %v", &point, &p)
+	}
+
+	// The pick ray through the projected point passes through the point
+	win = point
+	win.Project(&view, &projection, &viewport)
+	origin, direction, ok := PickRay(win.X, win.Y, &view, &projection, &viewport)
+	if !ok {
+		t.Errorf("Could not build a pick ray")
+	}
+	toPoint := point
+	toPoint.Subtract(&origin)
+	distance := toPoint.Dot(&direction)
+	direction.Scale(distance)
+	origin.Add(&direction)
+	if !origin.AreEqual(&point) {
+		t.Errorf("Pick ray misses %v, the closest point is %v", &point, &origin)
+	}
+}
+
+func TestFrustum(t *testing.T) {
+	var projection, view Mat4
+	projection.Perspective(90.0, 1.0, 1.0, 100.0)
+	eye := Vec3{0.0, 0.0, 10.0}
+	center := Vec3{0.0, 0.0, 0.0}
+	up := Vec3{0.0, 1.0, 0.0}
+	view.LookAt(&eye, &center, &up)
+	projection.Multiply(&view)
+
+	var f Frustum
+	f.ExtractPlanes(&projection)
+
+	if r := f.ClassifyPoint(&center); r != INSIDE_FRUSTUM {
+		t.Errorf("Origin should be inside the frustum but is %d", r)
+	}
+	behind := Vec3{0.0, 0.0, 20.0}
+	if r := f.ClassifyPoint(&behind); r != OUTSIDE_FRUSTUM {
+		t.Errorf("Point behind the camera should be outside the frustum but is %d", r)
+	}
+
+	spheres := []Sphere{
+		{Vec3{0.0, 0.0, 0.0}, 1.0},
+		{Vec3{0.0, 0.0, -200.0}, 1.0},
+		{Vec3{0.0, 0.0, -90.0}, 5.0},
+		{Vec3{12.0, 0.0, 0.0}, 1.0},
+	}
+	expected := []FrustumClassificationEnum{INSIDE_FRUSTUM, OUTSIDE_FRUSTUM, INTERSECTS_FRUSTUM, OUTSIDE_FRUSTUM}
+	result := f.ClassifySpheres(spheres, nil)
+	for i := range expected {
+		if result[i] != expected[i] {
+			t.Errorf("Sphere %d should be classified as %d but is %d", i, expected[i], result[i])
+		}
+	}
+	visible := f.CullSpheres(spheres, nil)
+	if len(visible) != 2 || visible[0] != 0 || visible[1] != 2 {
+		t.Errorf("Visible spheres should be [0 2] but are %v", visible)
+	}
+
+	min, max := Vec3{-1.0, -1.0, -1.0}, Vec3{1.0, 1.0, 1.0}
+	if r := f.ClassifyBox(&min, &max); r != INSIDE_FRUSTUM {
+		t.Errorf("Box around the origin should be inside but is %d", r)
+	}
+	min.Fill(-50.0, -1.0, -1.0)
+	if r := f.ClassifyBox(&min, &max); r != INTERSECTS_FRUSTUM {
+		t.Errorf("Long box should intersect the frustum but is %d", r)
+	}
+	min.Fill(20.0, -1.0, -1.0)
+	max.Fill(25.0, 1.0, 1.0)
+	if r := f.ClassifyBox(&min, &max); r != OUTSIDE_FRUSTUM {
+		t.Errorf("Box to the right should be outside but is %d", r)
+	}
+}
+
+func TestPlane(t *testing.T) {
+	var p Plane
+	a, b, c := Vec3{0.0, 0.0, 2.0}, Vec3{1.0, 0.0, 2.0}, Vec3{0.0, 1.0, 2.0}
+	p.FromPoints(&a, &b, &c)
+	if !FalmostEqual32(p.C, 1.0) || !FalmostEqual32(p.D, -2.0) {
+		t.Errorf("Plane through z = 2 should be (0, 0, 1, -2) but is %v", p)
+	}
+
+	var q Plane
+	normal := Vec3{0.0, 0.0, 5.0}
+	q.FromPointNormal(&a, &normal)
+	if q != p {
+		t.Errorf("FromPointNormal gives %v, FromPoints gives %v", q, p)
+	}
+
+	q = Plane{0.0, 0.0, 2.0, -4.0}
+	q.Normalize()
+	if q != p {
+		t.Errorf("Normalized plane should be %v but is %v", p, q)
+	}
+
+	v := Vec3{3.0, 4.0, 5.0}
+	if d := p.Distance(&v); !FalmostEqual32(d, 3.0) {
+		t.Errorf("Distance should be 3 but is %f", d)
+	}
+	if r := p.ClassifyPoint(&v); r != POINT_INFRONT_OF_PLANE {
+		t.Errorf("Point should be in front of the plane but is %d", r)
+	}
+	if r := p.ClassifyPoint(&Vec3{3.0, 4.0, -5.0}); r != POINT_BEHIND_PLANE {
+		t.Errorf("Point should be behind the plane but is %d", r)
+	}
+	if r := p.ClassifyPoint(&Vec3{3.0, 4.0, 2.0}); r != POINT_ON_PLANE {
+		t.Errorf("Point should be on the plane but is %d", r)
+	}
+	projected := p.ProjectPoint(&v)
+	expected := Vec3{3.0, 4.0, 2.0}
+	if !projected.AreEqual(&expected) {
+		t.Errorf("Projected point should be %v but is %v", &expected, &projected)
+	}
+
+	// Transforming the plane moves the points on it along
+	var m, r Mat4
+	m.Translation(1.0, 2.0, 3.0)
+	r.RotationX(PI / 2.0)
+	m.Multiply(&r)
+	q = p
+	if !q.Transform(&m) {
+		t.Errorf("Could not transform the plane")
+	}
+	for _, point := range []Vec3{a, b, c} {
+		point.Transform(&m)
+		if d := q.Distance(&point); !FalmostEqual32(d, 0.0) {
+			t.Errorf("Transformed point %v should be on the transformed plane %v", &point, q)
+		}
+	}
+
+	origin, direction := Vec3{1.0, 1.0, 10.0}, Vec3{0.0, 0.0, -2.0}
+	hit, dist, ok := p.IntersectRay(&origin, &direction)
+	expected.Fill(1.0, 1.0, 2.0)
+	if !ok || !hit.AreEqual(&expected) || !FalmostEqual32(dist, 4.0) {
+		t.Errorf("Ray should hit %v at distance 4 but hits %v at %f (%t)", &expected, &hit, dist, ok)
+	}
+	direction.Fill(0.0, 0.0, 1.0)
+	if _, _, ok := p.IntersectRay(&origin, &direction); ok {
+		t.Errorf("Ray pointing away from the plane should not hit it")
+	}
+
+	end := Vec3{1.0, 1.0, 0.0}
+	if hit, ok := p.IntersectSegment(&origin, &end); !ok || !hit.AreEqual(&expected) {
+		t.Errorf("Segment should hit %v but hits %v (%t)", &expected, &hit, ok)
+	}
+	end.Fill(1.0, 1.0, 3.0)
+	if _, ok := p.IntersectSegment(&origin, &end); ok {
+		t.Errorf("Segment above the plane should not hit it")
+	}
+
+	px := Plane{1.0, 0.0, 0.0, -1.0}
+	py := Plane{0.0, 1.0, 0.0, 2.0}
+	point, ok := IntersectPlanes(&px, &py, &p)
+	expected.Fill(1.0, -2.0, 2.0)
+	if !ok || !point.AreEqual(&expected) {
+		t.Errorf("Planes should meet in %v but meet in %v (%t)", &expected, &point, ok)
+	}
+	if _, ok := IntersectPlanes(&px, &px, &p); ok {
+		t.Errorf("Parallel planes should not meet in a point")
+	}
+}
+
+func TestAABB(t *testing.T) {
+	b := AABB2FromPoints([]Vec2{{1, 2}, {-1, 5}, {3, 0}})
+	if b.Min != (Vec2{-1, 0}) || b.Max != (Vec2{3, 5}) {
+		t.Errorf("Box of the points should be (-1, 0)-(3, 5) but is %v", b)
+	}
+	if b.Area() != 20.0 || b.Perimeter() != 18.0 {
+		t.Errorf("Box should have area 20 and perimeter 18 but has %f and %f", b.Area(), b.Perimeter())
+	}
+	if c, e := b.Center(), b.Extents(); c != (Vec2{1, 2.5}) || e != (Vec2{2, 2.5}) {
+		t.Errorf("Unexpected center %v and extents %v", c, e)
+	}
+	if !b.ContainsPoint(&Vec2{3, 5}) || b.ContainsPoint(&Vec2{3.1, 5}) {
+		t.Errorf("ContainsPoint is wrong on the border of %v", b)
+	}
+	poly := Poly{{0, 0}, {0, 1}, {2, 1}}
+	if pb := poly.Bounds(); pb.Max != (Vec2{2, 1}) {
+		t.Errorf("Bounds of the polygon should end at (2, 1) but are %v", pb)
+	}
+
+	var rotation Mat3
+	rotation.RotationZ(PI / 2.0)
+	r := b.Transform(&rotation)
+	expected := AABB2{Vec2{-5, -1}, Vec2{0, 3}}
+	if !r.Min.AreEqual(&expected.Min) || !r.Max.AreEqual(&expected.Max) {
+		t.Errorf("Rotated box should be %v but is %v", expected, r)
+	}
+
+	empty := EmptyAABB3()
+	if !empty.IsEmpty() || empty.Volume() != 0.0 {
+		t.Errorf("Empty box is not empty: %v", empty)
+	}
+	a := AABB3{Vec3{0, 0, 0}, Vec3{2, 2, 2}}
+	c := AABB3{Vec3{1, 1, 1}, Vec3{3, 4, 5}}
+	if a.Volume() != 8.0 || a.SurfaceArea() != 24.0 {
+		t.Errorf("Box should have volume 8 and surface area 24 but has %f and %f", a.Volume(), a.SurfaceArea())
+	}
+	if !a.Overlaps(&c) || a.Contains(&c) {
+		t.Errorf("Boxes %v and %v should overlap without containing each other", a, c)
+	}
+	u := a
+	u.Union(&c)
+	u.Union(&empty)
+	if u.Min != a.Min || u.Max != c.Max {
+		t.Errorf("Union should be %v-%v but is %v", a.Min, c.Max, u)
+	}
+	i := a
+	if !i.Intersection(&c) || i.Min != c.Min || i.Max != a.Max {
+		t.Errorf("Intersection should be %v-%v but is %v", c.Min, a.Max, i)
+	}
+	far := AABB3{Vec3{5, 5, 5}, Vec3{6, 6, 6}}
+	if a.Overlaps(&far) {
+		t.Errorf("Boxes %v and %v should not overlap", a, far)
+	}
+	i = a
+	if i.Intersection(&far) {
+		t.Errorf("Intersection of %v and %v should be empty but is %v", a, far, i)
+	}
+	grown := a
+	grown.Expand(1.0)
+	if !grown.Contains(&a) || grown.Volume() != 64.0 {
+		t.Errorf("Expanded box should contain %v and have volume 64 but is %v", a, grown)
+	}
+
+	// The transformed box encloses all the transformed corners tightly
+	var m, rot Mat4
+	m.Translation(10, 0, 0)
+	rot.RotationAxisAngle(Vec3{1, 1, 0}, 0.8)
+	m.Multiply(&rot)
+	tb := c.Transform(&m)
+	var corners []Vec3
+	for n := 0; n < 8; n++ {
+		corner := c.Min
+		if n&1 != 0 {
+			corner.X = c.Max.X
+		}
+		if n&2 != 0 {
+			corner.Y = c.Max.Y
+		}
+		if n&4 != 0 {
+			corner.Z = c.Max.Z
+		}
+		corner.Transform(&m)
+		corners = append(corners, corner)
+	}
+	tight := AABB3FromPoints(corners)
+	if !tb.Min.AreEqual(&tight.Min) || !tb.Max.AreEqual(&tight.Max) {
+		t.Errorf("Transformed box should be %v but is %v", tight, tb)
+	}
+
+	var projection Mat4
+	projection.Perspective(90.0, 1.0, 1.0, 100.0)
+	inFront := AABB3{Vec3{-1, -1, -6}, Vec3{1, 1, -4}}
+	pb := inFront.Transform(&projection)
+	corners = corners[:0]
+	for _, corner := range []Vec3{{-1, -1, -6}, {1, 1, -4}, {-1, 1, -4}, {1, -1, -6}, {-1, -1, -4}, {1, 1, -6}, {-1, 1, -6}, {1, -1, -4}} {
+		corner.TransformCoord(&projection)
+		corners = append(corners, corner)
+	}
+	tight = AABB3FromPoints(corners)
+	if !pb.Min.AreEqual(&tight.Min) || !pb.Max.AreEqual(&tight.Max) {
+		t.Errorf("Projected box should be %v but is %v", tight, pb)
+	}
+
+	var f Frustum
+	f.ExtractPlanes(&projection)
+	boxes := []AABB3{{Vec3{-1, -1, -6}, Vec3{1, 1, -4}}, a}
+	if visible := f.CullAABBs(boxes, nil); len(visible) != 1 || visible[0] != 0 {
+		t.Errorf("Only the first box should be visible, but %v are", visible)
+	}
+}
+
+func TestRay3(t *testing.T) {
+	r := Ray3{Vec3{0, 0, 10}, Vec3{0, 0, -1}}
+
+	hit, ok := r.IntersectPlane(&Plane{0, 0, 1, 0})
+	if !ok || hit.Distance != 10.0 || hit.Normal != (Vec3{0, 0, 1}) {
+		t.Errorf("Ray should hit the plane at distance 10 but hit is %v (%t)", hit, ok)
+	}
+
+	hit, ok = r.IntersectSphere(&Sphere{Vec3{0, 0, 0}, 2})
+	if !ok || !FalmostEqual32(hit.Distance, 8.0) || !hit.Normal.AreEqual(&Vec3{0, 0, 1}) {
+		t.Errorf("Ray should hit the sphere at distance 8 but hit is %v (%t)", hit, ok)
+	}
+	inside := Ray3{Vec3{0, 0, 0}, Vec3{1, 0, 0}}
+	hit, ok = inside.IntersectSphere(&Sphere{Vec3{0, 0, 0}, 2})
+	if !ok || !FalmostEqual32(hit.Distance, 2.0) {
+		t.Errorf("Ray from inside should leave the sphere at distance 2 but hit is %v (%t)", hit, ok)
+	}
+	if _, ok = r.IntersectSphere(&Sphere{Vec3{5, 0, 0}, 2}); ok {
+		t.Errorf("Ray should miss the sphere")
+	}
+
+	box := AABB3{Vec3{-1, -1, -1}, Vec3{1, 1, 1}}
+	hit, ok = r.IntersectAABB(&box)
+	expected := Vec3{0, 0, 1}
+	if !ok || !FalmostEqual32(hit.Distance, 9.0) || !hit.Point.AreEqual(&expected) || hit.Normal != expected {
+		t.Errorf("Ray should hit the box at %v but hit is %v (%t)", &expected, hit, ok)
+	}
+	hit, ok = inside.IntersectAABB(&box)
+	if !ok || !FalmostEqual32(hit.Distance, 1.0) || hit.Normal != (Vec3{1, 0, 0}) {
+		t.Errorf("Ray from inside should leave the box at distance 1 but hit is %v (%t)", hit, ok)
+	}
+	side := Ray3{Vec3{2, 0, 10}, Vec3{0, 0, -1}}
+	if _, ok = side.IntersectAABB(&box); ok {
+		t.Errorf("Ray parallel to the box should miss it")
+	}
+
+	// A box rotated by 45 degrees around the Z axis sticks out further
+	s := Fsqrt32(0.5)
+	obb := OBB{Vec3{0, 0, 0}, [3]Vec3{{s, s, 0}, {-s, s, 0}, {0, 0, 1}}, Vec3{1, 1, 1}}
+	ray := Ray3{Vec3{10, 0, 0}, Vec3{-1, 0, 0}}
+	hit, ok = ray.IntersectOBB(&obb)
+	if !ok || !FalmostEqual32(hit.Distance, 10.0-2.0*s) {
+		t.Errorf("Ray should hit the oriented box at distance %f but hit is %v (%t)", 10.0-2.0*s, hit, ok)
+	}
+
+	a, b, c := Vec3{-1, -1, 0}, Vec3{1, -1, 0}, Vec3{0, 1, 0}
+	hit, ok = r.IntersectTriangle(&a, &b, &c)
+	if !ok || !FalmostEqual32(hit.Distance, 10.0) || hit.Normal != (Vec3{0, 0, 1}) {
+		t.Errorf("Ray should hit the triangle at distance 10 but hit is %v (%t)", hit, ok)
+	}
+	if _, ok = side.IntersectTriangle(&a, &b, &c); ok {
+		t.Errorf("Ray should miss the triangle")
+	}
+}
+
+func TestRay2(t *testing.T) {
+	r := Ray2{Vec2{0, 0}, Vec2{1, 0}}
+	wall := Seg2{Vec2{3, -1}, Vec2{3, 1}}
+	hit, ok := r.IntersectSeg2(&wall)
+	if !ok || hit.Distance != 3.0 || hit.Normal != (Vec2{-1, 0}) {
+		t.Errorf("Ray should hit the wall at distance 3 but hit is %v (%t)", hit, ok)
+	}
+	behind := Seg2{Vec2{-3, -1}, Vec2{-3, 1}}
+	if _, ok = r.IntersectSeg2(&behind); ok {
+		t.Errorf("Ray should not hit the wall behind it")
+	}
+	short := Seg2{Vec2{3, 1}, Vec2{3, 2}}
+	if _, ok = r.IntersectSeg2(&short); ok {
+		t.Errorf("Ray should pass below the short wall")
+	}
+
+	p := Poly{{2, -1}, {2, 1}, {4, 1}, {4, -1}}
+	hit, ok = r.IntersectPoly(p)
+	if !ok || hit.Distance != 2.0 {
+		t.Errorf("Ray should hit the polygon at distance 2 but hit is %v (%t)", hit, ok)
+	}
+}
+
+func TestSeg2Isect(t *testing.T) {
+	u := Seg2{Vec2{0, 0}, Vec2{4, 0}}
+
+	r := u.SegIsect(&Seg2{Vec2{1, -1}, Vec2{1, 3}})
+	if r.Kind != SEGMENTS_INTERSECT || !FalmostEqual32(r.S, 0.25) || !FalmostEqual32(r.T, 0.25) {
+		t.Errorf("Crossing segments should intersect at 0.25, 0.25 but give %v", r)
+	}
+	// The lines cross, but not the segments
+	if r = u.SegIsect(&Seg2{Vec2{5, -1}, Vec2{5, 1}}); r.Kind != SEGMENTS_DISJOINT {
+		t.Errorf("Segments should be disjoint but give %v", r)
+	}
+	// Touching endpoints count as an intersection
+	if r = u.SegIsect(&Seg2{Vec2{4, 0}, Vec2{6, 3}}); r.Kind != SEGMENTS_INTERSECT || r.S != 1 || r.T != 0 {
+		t.Errorf("Segments should touch at their endpoints but give %v", r)
+	}
+	// Parallel segments neither intersect nor produce NaN
+	if r = u.SegIsect(&Seg2{Vec2{0, 1}, Vec2{4, 1}}); r.Kind != SEGMENTS_DISJOINT || r.S != r.S {
+		t.Errorf("Parallel segments should be disjoint but give %v", r)
+	}
+
+	r = u.SegIsect(&Seg2{Vec2{6, 0}, Vec2{2, 0}})
+	if r.Kind != SEGMENTS_OVERLAP || r.S != 0.5 || r.S1 != 1 || r.T != 1 || r.T1 != 0.5 {
+		t.Errorf("Collinear segments should overlap on 0.5..1 and 1..0.5 but give %v", r)
+	}
+	if r = u.SegIsect(&Seg2{Vec2{5, 0}, Vec2{6, 0}}); r.Kind != SEGMENTS_DISJOINT {
+		t.Errorf("Collinear segments apart should be disjoint but give %v", r)
+	}
+	if r = u.SegIsect(&Seg2{Vec2{4, 0}, Vec2{6, 0}}); r.Kind != SEGMENTS_INTERSECT || r.S != 1 || r.T != 0 {
+		t.Errorf("Collinear segments touching should intersect at their endpoints but give %v", r)
+	}
+	if r = u.SegIsect(&Seg2{Vec2{3, 0}, Vec2{3, 0}}); r.Kind != SEGMENTS_INTERSECT || r.S != 0.75 {
+		t.Errorf("Point on the segment should intersect at 0.75 but gives %v", r)
+	}
+
+	p := Vec2{2, 3}
+	if c := u.ClosestPoint(&p); c != (Vec2{2, 0}) || u.DistFromPoint(&p) != 3 {
+		t.Errorf("Closest point should be (2, 0) at distance 3 but is %v", c)
+	}
+	p = Vec2{7, 4}
+	if c := u.ClosestPoint(&p); c != (Vec2{4, 0}) || u.DistFromPoint(&p) != 5 {
+		t.Errorf("Closest point should be (4, 0) at distance 5 but is %v", c)
+	}
+}
+
+func polysArea(polys []PolyWithHoles) float32 {
+	var area float32
+	for _, p := range polys {
+		area -= p.Outer.SignedArea()
+		for _, h := range p.Holes {
+			area -= h.SignedArea()
+		}
+	}
+	return area
+}
+
+func square(x, y, size float32) Poly {
+	return Poly{{x, y}, {x, y + size}, {x + size, y + size}, {x + size, y}}
+}
+
+func TestPolyBoolean(t *testing.T) {
+	a := square(0, 0, 2)
+	b := square(1, 1, 2)
+
+	type booleanTest struct {
+		name   string
+		result []PolyWithHoles
+		polys  int
+		area   float32
+	}
+	tests := []booleanTest{
+		{"union", a.Union(b), 1, 7},
+		{"intersection", a.Intersection(b), 1, 1},
+		{"difference", a.Difference(b), 1, 3},
+		{"xor", a.Xor(b), 2, 6},
+		{"disjoint union", a.Union(square(5, 5, 1)), 2, 5},
+		{"disjoint intersection", a.Intersection(square(5, 5, 1)), 0, 0},
+		{"identical difference", a.Difference(square(0, 0, 2)), 0, 0},
+		{"identical union", a.Union(square(0, 0, 2)), 1, 4},
+		{"hole", square(0, 0, 4).Difference(square(1, 1, 1)), 1, 15},
+		{"shared edge", a.Union(square(2, 0, 2)), 1, 8},
+		{"touching corner", a.Union(square(2, 2, 2)), 2, 8},
+	}
+	for _, test := range tests {
+		if len(test.result) != test.polys || !FalmostEqual32(polysArea(test.result), test.area) {
+			t.Errorf("%s should give %d polygons with area %f but gives %v", test.name, test.polys, test.area, test.result)
+		}
+		for _, p := range test.result {
+			if p.Outer.SignedArea() >= 0 {
+				t.Errorf("%s: outer boundary %v is not clockwise", test.name, p.Outer)
+			}
+			for _, h := range p.Holes {
+				if h.SignedArea() <= 0 {
+					t.Errorf("%s: hole %v is not counter-clockwise", test.name, h)
+				}
+			}
+		}
+	}
+
+	holed := square(0, 0, 4).Difference(square(1, 1, 1))
+	if len(holed) != 1 || len(holed[0].Holes) != 1 || len(holed[0].Outer) != 4 {
+		t.Errorf("Cutting a square out of a square should leave a square with a hole but gives %v", holed)
+	}
+	merged := a.Union(square(2, 0, 2))
+	if len(merged) != 1 || len(merged[0].Outer) != 4 {
+		t.Errorf("Union of squares sharing an edge should be a rectangle but is %v", merged)
+	}
+
+	// Counter-clockwise input is accepted as well
+	ccw := square(1, 1, 2)
+	ccw.Reverse()
+	if r := a.Union(ccw); len(r) != 1 || !FalmostEqual32(polysArea(r), 7) {
+		t.Errorf("Union with a counter-clockwise polygon should have area 7 but is %v", r)
+	}
+
+	// Concave polygons and polygons with holes
+	l := Poly{{0, 0}, {0, 3}, {1, 3}, {1, 1}, {3, 1}, {3, 0}}
+	if r := l.Intersection(square(0, 0, 2)); len(r) != 1 || !FalmostEqual32(polysArea(r), 3) {
+		t.Errorf("Intersection of the L shape with a square should have area 3 but is %v", r)
+	}
+	ring := []PolyWithHoles{{Outer: square(0, 0, 6), Holes: []Poly{square(2, 2, 2)}}}
+	bar := []PolyWithHoles{{Outer: Poly{{-1, 2.5}, {-1, 3.5}, {7, 3.5}, {7, 2.5}}}}
+	if r := PolyBoolean(ring, bar, POLY_DIFFERENCE); len(r) != 2 || !FalmostEqual32(polysArea(r), 32-6+2) {
+		t.Errorf("Cutting a bar through the ring should leave two pieces with area 28 but gives %v", r)
+	}
+	if r := PolyBoolean(ring, bar, POLY_UNION); len(r) != 1 || len(r[0].Holes) != 2 || !FalmostEqual32(polysArea(r), 32+2+2) {
+		t.Errorf("Union of the ring and the bar should have two holes and area 36 but gives %v", r)
+	}
+	if r := PolyBoolean(ring, bar, POLY_INTERSECTION); len(r) != 2 || !FalmostEqual32(polysArea(r), 4) {
+		t.Errorf("Intersection of the ring and the bar should be two pieces with area 4 but gives %v", r)
+	}
+}
+
+func TestPolyClipConvex(t *testing.T) {
+	clip := square(0, 0, 2)
+
+	type clipTest struct {
+		name    string
+		subject Poly
+		area    float32
+	}
+	tests := []clipTest{
+		{"inside", square(0.5, 0.5, 1), 1},
+		{"outside", square(3, 3, 1), 0},
+		{"containing", square(-1, -1, 4), 4},
+		{"overlapping", square(1, 1, 2), 1},
+		{"identical", square(0, 0, 2), 4},
+		{"sharing an edge", square(2, 0, 2), 0},
+		{"triangle", Poly{{-1, 0}, {1, 2}, {3, 0}}, 3},
+		{"concave", Poly{{0, 0}, {0, 3}, {1, 3}, {1, 1}, {3, 1}, {3, 0}}, 3},
+	}
+	var c PolyClipper
+	for _, test := range tests {
+		result := c.ClipConvex(test.subject, clip)
+		if area := -result.SignedArea(); !FalmostEqual32(area, test.area) {
+			t.Errorf("Clipping %s polygon should give area %f but gives %v with area %f", test.name, test.area, result, area)
+		}
+		for i := range result {
+			if !FalmostEqual32(result[i].X, Fmin32(Fmax32(result[i].X, 0), 2)) ||
+				!FalmostEqual32(result[i].Y, Fmin32(Fmax32(result[i].Y, 0), 2)) {
+				t.Errorf("Clipping %s polygon gives %v outside of the clip polygon", test.name, result[i])
+			}
+			if i > 0 && result[i] == result[i-1] {
+				t.Errorf("Clipping %s polygon gives duplicate vertex %v", test.name, result[i])
+			}
+		}
+	}
+
+	// Clipping against a triangle
+	triangle := Poly{{0, 0}, {1, 2}, {2, 0}}
+	result := square(0, 0, 1).ClipConvex(triangle)
+	if area := -result.SignedArea(); !FalmostEqual32(area, 0.75) {
+		t.Errorf("Clipping the unit square by the triangle should give area 0.75 but gives %v", result)
+	}
+
+	// The clipper doesn't allocate once its buffers are large enough
+	subject := square(1, 1, 2)
+	allocs := testing.AllocsPerRun(100, func() {
+		c.ClipConvex(subject, clip)
+	})
+	if allocs != 0 {
+		t.Errorf("ClipConvex should not allocate but does %f times per run", allocs)
+	}
+}
+
+func BenchmarkPolyClipConvex(b *testing.B) {
+	var c PolyClipper
+	subject := Poly{{-1, 0}, {1, 4}, {3, 0}}
+	clip := square(0, 0, 2)
+	for i := 0; i < b.N; i++ {
+		c.ClipConvex(subject, clip)
+	}
+}
+
+func TestPolyMetrics(t *testing.T) {
+	s := square(1, 1, 2)
+	if s.SignedArea() != -4 || s.Area() != 4 || !s.IsClockwise() {
+		t.Errorf("Clockwise square should have signed area -4 but has %f", s.SignedArea())
+	}
+	if c := s.Centroid(); c != (Vec2{2, 2}) {
+		t.Errorf("Centroid of the square should be (2, 2) but is %v", c)
+	}
+	if l := s.Perimeter(); l != 8 {
+		t.Errorf("Perimeter of the square should be 8 but is %f", l)
+	}
+	if !s.IsConvex() || !s.IsSimple() || s.Validate() != nil {
+		t.Errorf("Square should be convex, simple and valid")
+	}
+
+	ccw := square(1, 1, 2)
+	ccw.Reverse()
+	if ccw.IsClockwise() || ccw.SignedArea() != 4 {
+		t.Errorf("Reversed square should be counter-clockwise")
+	}
+	if err := ccw.Validate(); err != ErrPolyCounterClockwise {
+		t.Errorf("Validate should report a counter-clockwise polygon but gives %v", err)
+	}
+	if err := (Poly{{0, 0}, {1, 1}}).Validate(); err != ErrPolyTooFewVertices {
+		t.Errorf("Validate should report too few vertices but gives %v", err)
+	}
+
+	// An L shape is concave
+	l := Poly{{0, 0}, {0, 2}, {1, 2}, {1, 1}, {2, 1}, {2, 0}}
+	if l.IsConvex() {
+		t.Errorf("L shape should be concave")
+	}
+	if arrow := (Poly{{0, 0}, {0, 2}, {1, 1}, {2, 2}, {2, 0}}); arrow.Validate() != nil || arrow.IsConvex() {
+		t.Errorf("Arrow shape should be valid but concave")
+	}
+
+	// A pentagram turns the same way at every vertex, but twice around
+	pentagram := make(Poly, 5)
+	for i := range pentagram {
+		a := -4 * math.Pi * float64(i) / 5
+		pentagram[i] = Vec2{float32(math.Cos(a)), float32(math.Sin(a))}
+	}
+	if pentagram.IsConvex() {
+		t.Errorf("Pentagram should not be convex")
+	}
+	if c := l.Centroid(); !FalmostEqual32(c.X, 5.0/6.0) || !FalmostEqual32(c.Y, 5.0/6.0) {
+		t.Errorf("Centroid of the L shape should be (5/6, 5/6) but is %v", c)
+	}
+	if !l.Contains(&Vec2{0.5, 1.5}) || l.Contains(&Vec2{1.5, 1.5}) || l.Contains(&Vec2{3, 0.5}) {
+		t.Errorf("Contains is wrong for the L shape")
+	}
+	if w := l.WindingNumber(&Vec2{0.5, 0.5}); w != -1 {
+		t.Errorf("Winding number inside a clockwise polygon should be -1 but is %d", w)
+	}
+	if c := l.ClosestPoint(&Vec2{1.5, 1.5}); !c.AreEqual(&Vec2{1.5, 1}) && !c.AreEqual(&Vec2{1, 1.5}) {
+		t.Errorf("Closest point of (1.5, 1.5) should be on the inner corner edges but is %v", c)
+	}
+	if c := l.ClosestPoint(&Vec2{-1, 3}); c != (Vec2{0, 2}) {
+		t.Errorf("Closest point of (-1, 3) should be (0, 2) but is %v", c)
+	}
+
+	bowtie := Poly{{0, 0}, {2, 2}, {2, 0}, {0, 2}}
+	if bowtie.IsSimple() {
+		t.Errorf("Bowtie should not be simple")
+	}
+	if err := bowtie.Validate(); err != ErrPolyNotSimple && err != ErrPolyCounterClockwise {
+		t.Errorf("Validate should reject the bowtie but gives %v", err)
+	}
+	spike := Poly{{0, 0}, {0, 2}, {2, 2}, {2, 0}, {3, 0}, {1, 0}}
+	if spike.IsSimple() {
+		t.Errorf("Polygon folding back onto itself should not be simple")
+	}
+	collinear := Poly{{1, 0}, {0, 0}, {0, 2}, {2, 2}, {2, 0}}
+	if !collinear.IsSimple() {
+		t.Errorf("Polygon with collinear consecutive vertices should be simple")
+	}
+	thirds := Poly{{0, 0}, {0.1, 0.3}, {0.2, 0.6}, {0.3, 0.9}, {1, 0}}
+	if !thirds.IsSimple() {
+		t.Errorf("Polygon with nearly collinear consecutive vertices should be simple")
+	}
+}
+
+func checkTriangulation(t *testing.T, name string, vertices []Vec2, indices []uint32, triangles int, area float32) {
+	if len(indices) != 3*triangles {
+		t.Errorf("%s should give %d triangles but gives %d", name, triangles, len(indices)/3)
+		return
+	}
+	var total float32
+	for i := 0; i < len(indices); i += 3 {
+		tri := Poly{vertices[indices[i]], vertices[indices[i+1]], vertices[indices[i+2]]}
+		if tri.SignedArea() > 0 {
+			t.Errorf("%s: triangle %v is not clockwise", name, tri)
+		}
+		total += tri.Area()
+	}
+	if !FalmostEqual32(total, area) {
+		t.Errorf("%s: triangles should cover area %f but cover %f", name, area, total)
+	}
+}
+
+func TestTriangulate(t *testing.T) {
+	s := square(0, 0, 2)
+	checkTriangulation(t, "square", s, s.Triangulate(), 2, 4)
+
+	l := Poly{{0, 0}, {0, 3}, {1, 3}, {1, 1}, {3, 1}, {3, 0}}
+	checkTriangulation(t, "L shape", l, l.Triangulate(), 4, 5)
+
+	ccw := Poly{{0, 0}, {3, 0}, {3, 1}, {1, 1}, {1, 3}, {0, 3}}
+	checkTriangulation(t, "counter-clockwise L shape", ccw, ccw.Triangulate(), 4, 5)
+
+	star := Poly{{0, 3}, {1, 1}, {3, 1}, {1.5, 0}, {2, -2}, {0, -1}, {-2, -2}, {-1.5, 0}, {-3, 1}, {-1, 1}}
+	checkTriangulation(t, "star", star, star.Triangulate(), 8, star.Area())
+
+	holed := PolyWithHoles{Outer: square(0, 0, 6), Holes: []Poly{square(1, 1, 1), square(3, 3, 2)}}
+	checkTriangulation(t, "square with holes", holed.Vertices(), holed.Triangulate(), 14, 36-1-4)
+
+	// Holes touching each other share a vertex
+	touching := PolyWithHoles{Outer: square(0, 0, 4), Holes: []Poly{square(1, 1, 1), square(2, 2, 1)}}
+	checkTriangulation(t, "touching holes", touching.Vertices(), touching.Triangulate(), 12, 16-2)
+
+	// The bridge must not go to the far end of the slanted edge, it would
+	// cut through the hole
+	slanted := PolyWithHoles{Outer: Poly{{0, 0}, {8, 10}, {10, 10}, {10, 0}}, Holes: []Poly{{{5, 2}, {6, 2}, {6, 3}, {5, 3}}}}
+	checkTriangulation(t, "hole next to slanted edge", slanted.Vertices(), slanted.Triangulate(), 8, 60-1)
+
+	indices16, err := holed.Triangulate16()
+	indices := holed.Triangulate()
+	if err != nil || len(indices16) != len(indices) {
+		t.Errorf("16 bit triangulation failed: %v", err)
+	}
+	for i := range indices16 {
+		if uint32(indices16[i]) != indices[i] {
+			t.Errorf("16 bit index %d is %d instead of %d", i, indices16[i], indices[i])
+			break
+		}
+	}
+
+	big := make(Poly, 70000)
+	for i := range big {
+		a := -2 * math.Pi * float64(i) / float64(len(big))
+		big[i] = Vec2{float32(math.Cos(a)), float32(math.Sin(a))}
+	}
+	if _, err := big.Triangulate16(); err != ErrTooManyVertices {
+		t.Errorf("Triangulate16 should refuse %d vertices but gives %v", len(big), err)
+	}
+
+	if indices := (Poly{{0, 0}, {1, 1}}).Triangulate(); len(indices) != 0 {
+		t.Errorf("Degenerate polygon should give no triangles but gives %v", indices)
+	}
+}
+
+func TestConvexHull2(t *testing.T) {
+	points := []Vec2{{0, 0}, {1, 1}, {2, 2}, {2, 0}, {0, 2}, {1, 0}, {0.5, 1.5}, {2, 2}}
+	hull := ConvexHull2(points)
+	if len(hull) != 4 || !hull.IsClockwise() || hull.Area() != 4 {
+		t.Errorf("Hull should be the clockwise 2x2 square but is %v", hull)
+	}
+	if err := hull.Validate(); err != nil {
+		t.Errorf("Hull should be a valid polygon but is not: %v", err)
+	}
+	for i := range points {
+		closest := hull.ClosestPoint(&points[i])
+		if hull.WindingNumber(&points[i]) == 0 && !closest.AreEqual(&points[i]) {
+			t.Errorf("Point %v is outside of the hull", points[i])
+		}
+	}
+
+	if hull = ConvexHull2([]Vec2{{0, 0}, {1, 1}, {2, 2}}); len(hull) != 2 {
+		t.Errorf("Hull of collinear points should be a segment but is %v", hull)
+	}
+}
+
+func TestConvexHull3(t *testing.T) {
+	// Corners of a cube, its center and some points inside
+	var points []Vec3
+	for i := 0; i < 8; i++ {
+		points = append(points, Vec3{float32(i & 1), float32((i >> 1) & 1), float32((i >> 2) & 1)})
+	}
+	points = append(points, Vec3{0.5, 0.5, 0.5}, Vec3{0.2, 0.7, 0.1}, Vec3{0.5, 0.5, 1})
+
+	faces := ConvexHull3(points)
+	if len(faces) != 12 {
+		t.Errorf("Hull of the cube should have 12 triangles but has %d", len(faces))
+	}
+	center := Vec3{0.5, 0.5, 0.5}
+	var area float32
+	for _, f := range faces {
+		for _, v := range f.Vertices {
+			if v >= 8 {
+				t.Errorf("Face %v uses a point which is not a corner", f)
+			}
+		}
+		if d := f.Plane.DotCoord(&center); !FalmostEqual32(d, -0.5) {
+			t.Errorf("Face plane %v should point outwards, 0.5 away from the center", f.Plane)
+		}
+		for i := range points {
+			if f.Plane.DotCoord(&points[i]) > 1e-4 {
+				t.Errorf("Point %v lies outside of face %v", points[i], f)
+			}
+		}
+		a, b, c := points[f.Vertices[0]], points[f.Vertices[1]], points[f.Vertices[2]]
+		b.Subtract(&a)
+		c.Subtract(&a)
+		b.Cross(&c)
+		if n := f.Plane.Normal(); b.Dot(&n) <= 0 {
+			t.Errorf("Face %v is not counter-clockwise seen from outside", f)
+		}
+		area += b.Length() / 2
+	}
+	if !FalmostEqual32(area, 6) {
+		t.Errorf("Surface of the cube hull should be 6 but is %f", area)
+	}
+
+	// Points on a sphere are all on the hull
+	var sphere []Vec3
+	for i := 0; i < 200; i++ {
+		z := 1 - 2*(float64(i)+0.5)/200
+		r := math.Sqrt(1 - z*z)
+		a := float64(i) * 2.399963
+		sphere = append(sphere, Vec3{float32(r * math.Cos(a)), float32(r * math.Sin(a)), float32(z)})
+	}
+	faces = ConvexHull3(sphere)
+	used := make(map[int]bool)
+	for _, f := range faces {
+		for _, v := range f.Vertices {
+			used[v] = true
+		}
+	}
+	if len(used) != len(sphere) || len(faces) != 2*len(sphere)-4 {
+		t.Errorf("Hull of %d points on a sphere should use all of them in %d faces, but uses %d in %d faces",
+			len(sphere), 2*len(sphere)-4, len(used), len(faces))
+	}
+
+	// Many random points on a sphere are nearly coplanar with their
+	// neighbors. The hull must still be closed and contain all of them.
+	for seed := int64(1); seed <= 5; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		sphere = sphere[:0]
+		for len(sphere) < 2000 {
+			v := Vec3{float32(r.NormFloat64()), float32(r.NormFloat64()), float32(r.NormFloat64())}
+			if v.LengthSq() > 1e-4 {
+				sphere = append(sphere, v.Normalized().Times(10))
+			}
+		}
+		checkClosedHull(t, fmt.Sprintf("random sphere %d", seed), sphere, ConvexHull3(sphere))
+	}
+	if first, second := ConvexHull3(sphere), ConvexHull3(sphere); !reflect.DeepEqual(first, second) {
+		t.Errorf("Hull faces should come in the same order every time")
+	}
+
+	if faces = ConvexHull3([]Vec3{{0, 0, 0}, {1, 0, 0}, {0, 1, 0}, {1, 1, 0}}); faces != nil {
+		t.Errorf("Coplanar points should have no hull but give %v", faces)
+	}
+}
+
+func checkClosedHull(t *testing.T, name string, points []Vec3, faces []HullFace) {
+	// Every edge is shared by exactly two faces running through it in
+	// opposite directions
+	edges := make(map[[2]int]int)
+	for _, f := range faces {
+		for k := 0; k < 3; k++ {
+			edges[[2]int{f.Vertices[k], f.Vertices[(k+1)%3]}]++
+		}
+	}
+	for edge, n := range edges {
+		if n != 1 || edges[[2]int{edge[1], edge[0]}] != 1 {
+			t.Errorf("%s: hull is not closed at edge %v", name, edge)
+			return
+		}
+	}
+	for _, f := range faces {
+		for i := range points {
+			if d := f.Plane.DotCoord(&points[i]); d > 5e-4 {
+				t.Errorf("%s: point %v lies %f outside of face %v", name, points[i], d, f)
+				return
+			}
+		}
+	}
+}
+
+func TestDelaunay(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var points []Vec2
+	for i := 0; i < 300; i++ {
+		points = append(points, Vec2{r.Float32()*10 - 5, r.Float32() * 4})
+	}
+	// Cocircular points on a grid and a duplicate
+	for x := 0; x < 5; x++ {
+		for y := 0; y < 5; y++ {
+			points = append(points, Vec2{float32(x) - 10, float32(y)})
+		}
+	}
+	points = append(points, points[0])
+
+	triangles := Delaunay(points)
+	hull := ConvexHull2(points)
+	var area float32
+	for i, tri := range triangles {
+		a, b, c := &points[tri.Vertices[0]], &points[tri.Vertices[1]], &points[tri.Vertices[2]]
+		poly := Poly{*a, *b, *c}
+		if !poly.IsClockwise() {
+			t.Errorf("Triangle %v is not clockwise", tri)
+		}
+		area += poly.Area()
+
+		for j := range points {
+			if inCircle([]float64{float64(a.X), float64(c.X), float64(b.X)}, []float64{float64(a.Y), float64(c.Y), float64(b.Y)},
+				0, 1, 2, float64(points[j].X), float64(points[j].Y)) > 1e-9 {
+				t.Errorf("Point %v is inside the circumcircle of triangle %v", points[j], tri)
+			}
+		}
+		for k, n := range tri.Neighbors {
+			if n == -1 {
+				continue
+			}
+			u, v := tri.Vertices[k], tri.Vertices[(k+1)%3]
+			back := triangles[n]
+			if back.Neighbors[0] != i && back.Neighbors[1] != i && back.Neighbors[2] != i {
+				t.Errorf("Neighbor %d of triangle %d doesn't point back to it", n, i)
+			}
+			found := false
+			for m := 0; m < 3; m++ {
+				found = found || (back.Vertices[m] == v && back.Vertices[(m+1)%3] == u)
+			}
+			if !found {
+				t.Errorf("Neighbor %d of triangle %d doesn't share the edge %d-%d", n, i, u, v)
+			}
+		}
+	}
+	if Fabs32(area/hull.Area()-1) > 1e-5 {
+		t.Errorf("Triangles should cover the convex hull area %f but cover %f", hull.Area(), area)
+	}
+
+	// Triangles along the hull are easily lost to a super triangle, only
+	// the edges on the hull may have no neighbor
+	for seed := int64(1); seed <= 50; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		points = points[:0]
+		for i := 0; i < 100; i++ {
+			points = append(points, Vec2{r.Float32() * 100, r.Float32() * 100})
+		}
+		triangles = Delaunay(points)
+		hull = ConvexHull2(points)
+		area = 0
+		for _, tri := range triangles {
+			area += (Poly{points[tri.Vertices[0]], points[tri.Vertices[1]], points[tri.Vertices[2]]}).Area()
+			for k, n := range tri.Neighbors {
+				u, v := points[tri.Vertices[k]], points[tri.Vertices[(k+1)%3]]
+				edge := v.Minus(u)
+				for j := range points {
+					if d := points[j].Minus(u); n == -1 && cross2(&edge, &d) > 1e-3 {
+						t.Errorf("Edge %v-%v of seed %d has no neighbor but isn't on the hull", u, v, seed)
+						break
+					}
+				}
+			}
+		}
+		if Fabs32(area/hull.Area()-1) > 1e-5 {
+			t.Errorf("Triangles of seed %d should cover the convex hull area %f but cover %f", seed, hull.Area(), area)
+		}
+	}
+
+	if Delaunay([]Vec2{{0, 0}, {1, 1}, {2, 2}}) != nil {
+		t.Errorf("Collinear points should have no triangulation")
+	}
+}
+
+func TestVoronoi(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	var points []Vec2
+	for i := 0; i < 100; i++ {
+		points = append(points, Vec2{r.Float32() * 8, r.Float32() * 6})
+	}
+	points = append(points, points[3])
+	bounds := AABB2{Vec2{-1, -1}, Vec2{9, 7}}
+
+	cells := Voronoi(points, bounds)
+	if len(cells) != len(points) || cells[len(points)-1] != nil {
+		t.Errorf("Duplicate point should have no cell")
+	}
+	var area float32
+	for i, cell := range cells[:len(points)-1] {
+		if err := cell.Validate(); err != nil || !cell.IsConvex() {
+			t.Errorf("Cell %d should be valid and convex: %v", i, err)
+		}
+		if !cell.Contains(&points[i]) {
+			t.Errorf("Cell %d doesn't contain its point %v", i, points[i])
+		}
+		area += cell.Area()
+		// The nearest point to each vertex is the owner of the cell
+		for _, v := range cell {
+			dist := func(p Vec2) float32 {
+				p.Subtract(&v)
+				return p.Length()
+			}
+			for j := range points {
+				if dist(points[j]) < dist(points[i])-1e-3 {
+					t.Errorf("Vertex %v of cell %d is closer to point %d", v, i, j)
+				}
+			}
+		}
+	}
+	if !FalmostEqual32(area/bounds.Area(), 1) {
+		t.Errorf("Cells should cover the bounds area %f but cover %f", bounds.Area(), area)
+	}
+
+	if cells = Voronoi([]Vec2{{0, 0}, {2, 0}}, bounds); !FalmostEqual32(cells[0].Area(), 16) || !FalmostEqual32(cells[1].Area(), 64) {
+		t.Errorf("Cells of two points should be split at their bisector but are %v", cells)
+	}
+}
+
+func TestVisibilityPolygon(t *testing.T) {
+	bounds := AABB2{Vec2{0, 0}, Vec2{10, 10}}
+	eye := Vec2{5, 5}
+	if p := VisibilityPolygon(eye, bounds, nil); len(p) != 4 || !p.IsClockwise() || !FalmostEqual32(p.Area(), 100) {
+		t.Errorf("Without walls the whole box should be visible but %v is", p)
+	}
+	if p := VisibilityPolygon(Vec2{11, 5}, bounds, nil); p != nil {
+		t.Errorf("Eye outside of the bounds should see nothing but sees %v", p)
+	}
+
+	// A wall left of the eye casts a trapezoid shadow, the wall sticking
+	// out of the box is clipped.
+	walls := []Seg2{{Vec2{2, 3}, Vec2{2, 7}}, {Vec2{8, -5}, Vec2{8, 1}}}
+	p := VisibilityPolygon(eye, bounds, walls)
+	if err := p.Validate(); err != nil {
+		t.Errorf("Visibility polygon should be valid but is not: %v", err)
+	}
+	if shadow := float32(2 * (4 + 20.0/3) / 2); !FalmostEqual32(p.Area()/(100-shadow), 1) {
+		t.Errorf("Visible area should be %f but is %f", 100-shadow, p.Area())
+	}
+
+	// Walls crossing the bounds are clipped exactly onto them, the first one
+	// points at the eye and casts no shadow
+	walls = []Seg2{{Vec2{-1, 2}, Vec2{3, 4}}}
+	if p = VisibilityPolygon(eye, bounds, walls); len(p) != 4 || !FalmostEqual32(p.Area(), 100) {
+		t.Errorf("Wall pointing at the eye should cast no shadow but %v is visible", p)
+	}
+	walls = []Seg2{{Vec2{-1, 3}, Vec2{3, 3}}}
+	p = VisibilityPolygon(eye, bounds, walls)
+	checkVisibility(t, "wall crossing the bounds", eye, p, walls, rand.New(rand.NewSource(1)))
+	if !FalmostEqual32(p.Area(), 100-4.5) {
+		t.Errorf("Visible area should be %f but is %f", 100-4.5, p.Area())
+	}
+
+	// Compare against brute force with random walls, some sharing endpoints
+	// and some crossing the bounds
+	for seed := int64(1); seed <= 20; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		walls = walls[:0]
+		for len(walls) < 40 {
+			a := Vec2{r.Float32()*12 - 1, r.Float32()*12 - 1}
+			if len(walls) > 0 && r.Intn(3) == 0 {
+				a = walls[len(walls)-1].B
+			}
+			wall := Seg2{a, Vec2{a.X + r.Float32()*4 - 2, a.Y + r.Float32()*4 - 2}}
+			ok := wall.DistFromPoint(&eye) > 0.1
+			for i := range walls {
+				isect := wall.SegIsect(&walls[i])
+				if isect.Kind != SEGMENTS_DISJOINT && !(isect.S == 0 && walls[i].B == a) {
+					ok = false
+				}
+			}
+			if ok {
+				walls = append(walls, wall)
+			}
+		}
+		p = VisibilityPolygon(eye, bounds, walls)
+		checkVisibility(t, fmt.Sprintf("random walls %d", seed), eye, p, walls, r)
+	}
+}
+
+func checkVisibility(t *testing.T, name string, eye Vec2, p Poly, walls []Seg2, r *rand.Rand) {
+	if !p.IsClockwise() || !p.IsSimple() {
+		t.Errorf("%s: visibility polygon should be clockwise and simple", name)
+		return
+	}
+	for i := 0; i < 2000; i++ {
+		v := Vec2{r.Float32() * 10, r.Float32() * 10}
+		sight := Seg2{eye, v}
+		visible := true
+		for j := range walls {
+			if sight.SegIsect(&walls[j]).Kind != SEGMENTS_DISJOINT {
+				visible = false
+			}
+		}
+		closest := p.ClosestPoint(&v)
+		closest.Subtract(&v)
+		if p.Contains(&v) != visible && closest.Length() > 1e-3 {
+			t.Errorf("%s: point %v should be visible: %t", name, v, visible)
+			return
+		}
+	}
+}
+
+func TestPolyOffset(t *testing.T) {
+	sq := square(0, 0, 10)
+	for _, c := range []struct {
+		join     OffsetJoinEnum
+		distance float32
+		area     float32
+	}{
+		{OFFSET_MITER, 1, 144},
+		{OFFSET_SQUARE, 1, 140 + 4*(1-Fsqr32(2-Fsqrt32(2))/2)},
+		{OFFSET_ROUND, 1, 140 + offsetRoundSegments/2*float32(math.Sin(2*math.Pi/offsetRoundSegments))},
+		{OFFSET_MITER, -1, 64},
+		{OFFSET_ROUND, -1, 64},
+		{OFFSET_SQUARE, -4.5, 1},
+		{OFFSET_MITER, -6, 0},
+	} {
+		result := sq.Offset(c.distance, c.join)
+		if c.area == 0 {
+			if len(result) != 0 {
+				t.Errorf("Offset %f should make the square vanish but gives %v", c.distance, result)
+			}
+			continue
+		}
+		if len(result) != 1 || !FalmostEqual32(polysArea(result)/c.area, 1) {
+			t.Errorf("Offset %f with join %d should give area %f but gives %v", c.distance, c.join, c.area, result)
+		} else if err := result[0].Outer.Validate(); err != nil {
+			t.Errorf("Offset %f with join %d is not valid: %v", c.distance, c.join, err)
+		}
+	}
+
+	// A C shape whose slit closes when growing, leaving a hole
+	c := Poly{{0, 0}, {0, 10}, {4, 10}, {4, 8}, {2, 8}, {2, 2}, {8, 2}, {8, 8}, {6, 8}, {6, 10}, {10, 10}, {10, 0}}
+	result := c.Offset(1.5, OFFSET_MITER)
+	if len(result) != 1 || len(result[0].Holes) != 1 || !FalmostEqual32(polysArea(result), 169-9) {
+		t.Errorf("Grown C shape should be a square with a hole but is %v", result)
+	}
+	if result := c.Offset(-0.5, OFFSET_MITER); len(result) != 1 || !FalmostEqual32(polysArea(result), 60-0.5*c.Perimeter()+0.25*(8-4)) {
+		t.Errorf("Shrunk C shape should be a thinner C shape but is %v", result)
+	}
+
+	// Every vertex of a round offset is on an arc or where two offset edges
+	// cross, so it's exactly the distance away from the polygon.
+	var star Poly
+	for i := 0; i < 14; i++ {
+		r := float64(5 - 3*(i%2))
+		a := -float64(i) * 2 * math.Pi / 14
+		star = append(star, Vec2{float32(r * math.Cos(a)), float32(r * math.Sin(a))})
+	}
+	for _, distance := range []float32{0.7, -0.7} {
+		result = star.Offset(distance, OFFSET_ROUND)
+		if len(result) != 1 || len(result[0].Holes) != 0 || !result[0].Outer.IsSimple() {
+			t.Errorf("Offset star should be a single simple polygon but is %v", result)
+			continue
+		}
+		for _, v := range result[0].Outer {
+			closest := star.ClosestPoint(&v)
+			closest.Subtract(&v)
+			if !FalmostEqual32(closest.Length()/Fabs32(distance), 1) {
+				t.Errorf("Vertex %v of the offset star is %f away from the star instead of %f", v, closest.Length(), distance)
+			}
+		}
+	}
+
+	// Shrinking a dumbbell splits it in two
+	dumbbell := Poly{{0, 0}, {0, 4}, {4, 4}, {4, 2.5}, {6, 2.5}, {6, 4}, {10, 4}, {10, 0}, {6, 0}, {6, 1.5}, {4, 1.5}, {4, 0}}
+	if result := dumbbell.Offset(-1, OFFSET_MITER); len(result) != 2 || !FalmostEqual32(polysArea(result), 8) {
+		t.Errorf("Shrunk dumbbell should be two squares but is %v", result)
+	}
+}
+
+func TestMinkowski(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	randomConvex := func(n int, x, y float32) Poly {
+		var points []Vec2
+		for i := 0; i < n; i++ {
+			points = append(points, Vec2{x + r.Float32()*4, y + r.Float32()*4})
+		}
+		return ConvexHull2(points)
+	}
+
+	shapes := []Poly{
+		square(0, 0, 2),
+		{{0, 0}, {1, 2}, {2, 0}},
+		{{1, 1}},
+		{{0, 0}, {3, 1}},
+		randomConvex(20, 1, 1),
+		randomConvex(30, -2, 3),
+	}
+	ccw := Poly{{0, 0}, {2, 0}, {1, 2}}
+	shapes = append(shapes, ccw)
+
+	for i, a := range shapes {
+		for j, b := range shapes {
+			var sums []Vec2
+			for _, u := range a {
+				for _, v := range b {
+					sums = append(sums, Vec2{u.X + v.X, u.Y + v.Y})
+				}
+			}
+			expected := removeCollinear(ConvexHull2(sums))
+			sum := a.MinkowskiSum(b)
+			if len(sum) != len(expected) || !FalmostEqual32(sum.Area(), expected.Area()) {
+				t.Errorf("Minkowski sum of shapes %d and %d should be %v but is %v", i, j, expected, sum)
+				continue
+			}
+			if len(sum) >= 3 && (!sum.IsClockwise() || !sum.IsConvex()) {
+				t.Errorf("Minkowski sum of shapes %d and %d should be clockwise and convex", i, j)
+			}
+			for k := range expected {
+				found := false
+				for l := range sum {
+					found = found || sum[l].AreEqual(&expected[k])
+				}
+				if !found {
+					t.Errorf("Minkowski sum of shapes %d and %d is missing the vertex %v", i, j, expected[k])
+				}
+			}
+		}
+	}
+
+	origin := Vec2{0, 0}
+	a := square(0, 0, 2)
+	if d := a.MinkowskiDifference(square(1, 1, 2)); !d.Contains(&origin) {
+		t.Errorf("Difference of overlapping squares should contain the origin but is %v", d)
+	}
+	if d := a.MinkowskiDifference(square(2.5, 0, 2)); d.Contains(&origin) || !FalmostEqual32(d.Area(), 16) {
+		t.Errorf("Difference of separate squares shouldn't contain the origin but is %v", d)
+	}
+}
+
+func TestNavMesh(t *testing.T) {
+	room := square(0, 0, 10)
+	wall := Poly{{4, -1}, {4, 8}, {6, 8}, {6, -1}}
+	pillar := square(7, 8.5, 1)
+	mesh := NavMeshFromPoly(room, []Poly{wall, pillar})
+
+	var area float32
+	for c, cell := range mesh.Cells {
+		if !cell.Poly.IsClockwise() || !cell.Poly.IsConvex() {
+			t.Errorf("Cell %d should be clockwise and convex but is %v", c, cell.Poly)
+		}
+		area += cell.Poly.Area()
+		for _, p := range cell.Portals {
+			back := false
+			for _, q := range mesh.Cells[p.Cell].Portals {
+				back = back || (q.Cell == c && q.Edge.A == p.Edge.B && q.Edge.B == p.Edge.A)
+			}
+			if !back {
+				t.Errorf("Portal %v of cell %d has no counterpart in cell %d", p.Edge, c, p.Cell)
+			}
+		}
+	}
+	if !FalmostEqual32(area, 100-2*8-1) {
+		t.Errorf("Cells should cover the walkable area %f but cover %f", 100-2*8-1.0, area)
+	}
+	if len(mesh.Cells) >= 20 {
+		t.Errorf("Triangles should have been merged into fewer cells, got %d", len(mesh.Cells))
+	}
+
+	path := mesh.FindPath(Vec2{1, 1}, Vec2{9, 1})
+	expected := []Vec2{{1, 1}, {4, 8}, {6, 8}, {9, 1}}
+	if len(path) != len(expected) {
+		t.Errorf("Path around the wall should be %v but is %v", expected, path)
+	} else {
+		for i := range path {
+			if !path[i].AreEqual(&expected[i]) {
+				t.Errorf("Path around the wall should be %v but is %v", expected, path)
+				break
+			}
+		}
+	}
+	if path := mesh.FindPath(Vec2{1, 1}, Vec2{2, 3}); len(path) != 2 {
+		t.Errorf("Path within a cell should be a straight line but is %v", path)
+	}
+	if path := mesh.FindPath(Vec2{1, 1}, Vec2{5, 5}); path != nil {
+		t.Errorf("Path into the wall should not exist but is %v", path)
+	}
+
+	split := NavMeshFromPoly(room, []Poly{{{4, -1}, {4, 11}, {6, 11}, {6, -1}}})
+	if path := split.FindPath(Vec2{1, 1}, Vec2{9, 1}); path != nil {
+		t.Errorf("Path between separate rooms should not exist but is %v", path)
+	}
+
+	// Paths between random points only cross walkable area
+	r := rand.New(rand.NewSource(5))
+	var obstacles []Poly
+	for i := 0; i < 8; i++ {
+		obstacles = append(obstacles, square(r.Float32()*9, r.Float32()*9, 0.5+r.Float32()))
+	}
+	mesh = NavMeshFromPoly(room, obstacles)
+	for i := 0; i < 50; i++ {
+		start, goal := Vec2{r.Float32() * 10, r.Float32() * 10}, Vec2{r.Float32() * 10, r.Float32() * 10}
+		path := mesh.FindPath(start, goal)
+		if path == nil {
+			continue
+		}
+		for j := 1; j < len(path); j++ {
+			segment := Seg2{path[j-1], path[j]}
+			for k := 1; k < 20; k++ {
+				v := segment.At(float32(k) / 20)
+				for _, o := range obstacles {
+					closest := o.ClosestPoint(&v)
+					closest.Subtract(&v)
+					if o.Contains(&v) && closest.Length() > 1e-3 {
+						t.Errorf("Path %v from %v to %v crosses obstacle %v", path, start, goal, o)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestVecValues(t *testing.T) {
+	a2, b2 := Vec2{1, 2}, Vec2{-3, 0.5}
+	c2 := a2
+	c2.Add(&b2)
+	c2.Scale(2)
+	c2.Cross()
+	if d2 := a2.Plus(b2).Times(2).Crossed(); d2 != c2 {
+		t.Errorf("Value and in-place Vec2 results should match: %v vs %v", d2, c2)
+	}
+	if d2 := a2.Minus(b2); d2 != (Vec2{4, 1.5}) || a2 != (Vec2{1, 2}) {
+		t.Errorf("Minus should return %v and leave the receiver unchanged, got %v", Vec2{4, 1.5}, d2)
+	}
+
+	a3, b3 := Vec3{1, 2, 3}, Vec3{-2, 0.5, 4}
+	c3 := a3
+	c3.Subtract(&b3)
+	c3.Cross(&a3)
+	c3.Normalize()
+	if d3 := a3.Minus(b3).Crossed(a3).Normalized(); !d3.AreEqual(&c3) {
+		t.Errorf("Value and in-place Vec3 results should match: %v vs %v", d3, c3)
+	}
+	var m Mat4
+	m.RotationAxisAngle(Vec3{0, 1, 0}, 1)
+	m[12], m[13], m[14] = 1, 2, 3
+	c3 = a3
+	c3.Transform(&m)
+	if d3 := a3.Transformed(&m); !d3.AreEqual(&c3) {
+		t.Errorf("Transformed Vec3 should be %v but is %v", c3, d3)
+	}
+
+	a4, b4 := Vec4{1, 2, 3, 4}, Vec4{0.5, -1, 2, 1}
+	c4 := a4
+	c4.Subtract(&b4)
+	if d4 := a4.Minus(b4); d4 != c4 || d4 != (Vec4{0.5, 3, 1, 3}) {
+		t.Errorf("Vec4 difference should be %v but is %v and %v", Vec4{0.5, 3, 1, 3}, d4, c4)
+	}
+	c4 = a4
+	c4.Transform(&m)
+	c4.Cross(&b4)
+	if d4 := a4.Transformed(&m).Crossed(b4).Plus(b4).Minus(b4).Times(1); !d4.AreEqual(&c4) {
+		t.Errorf("Value and in-place Vec4 results should match: %v vs %v", d4, c4)
+	}
+	if d4 := a4.Normalized(); !FalmostEqual32(d4.Length(), 1) {
+		t.Errorf("Normalized Vec4 should have length 1 but is %v", d4)
+	}
+}
+
+var benchVec3 Vec3
+
+func BenchmarkVec3Add(b *testing.B) {
+	v, x := Vec3{1, 2, 3}, Vec3{0.5, 0.25, 0.125}
+	for i := 0; i < b.N; i++ {
+		v.Add(&x)
+	}
+	benchVec3 = v
+}
+
+func BenchmarkVec3Plus(b *testing.B) {
+	v, x := Vec3{1, 2, 3}, Vec3{0.5, 0.25, 0.125}
+	for i := 0; i < b.N; i++ {
+		v = v.Plus(x)
+	}
+	benchVec3 = v
+}
+
+func BenchmarkVec3CrossNormalize(b *testing.B) {
+	v, x := Vec3{1, 2, 3}, Vec3{0.5, 0.25, 0.125}
+	for i := 0; i < b.N; i++ {
+		v.Cross(&x)
+		v.Normalize()
+		v.Scale(2)
+	}
+	benchVec3 = v
+}
+
+func BenchmarkVec3CrossedNormalized(b *testing.B) {
+	v, x := Vec3{1, 2, 3}, Vec3{0.5, 0.25, 0.125}
+	for i := 0; i < b.N; i++ {
+		v = v.Crossed(x).Normalized().Times(2)
+	}
+	benchVec3 = v
+}
+
+func BenchmarkVec3Transform(b *testing.B) {
+	var m Mat4
+	m.RotationAxisAngle(Vec3{0, 1, 0}, 0.1)
+	var v Vec3
+	x := Vec3{1, 2, 3}
+	for i := 0; i < b.N; i++ {
+		v = x
+		v.Transform(&m)
+	}
+	benchVec3 = v
+}
+
+func BenchmarkVec3Transformed(b *testing.B) {
+	var m Mat4
+	m.RotationAxisAngle(Vec3{0, 1, 0}, 0.1)
+	var v Vec3
+	x := Vec3{1, 2, 3}
+	for i := 0; i < b.N; i++ {
+		v = x.Transformed(&m)
+	}
+	benchVec3 = v
+}
+
+func TestVecToolkit(t *testing.T) {
+	a, b := Vec3{1, 0, 0}, Vec3{0, 3, 4}
+	if d := a.Distance(b); !FalmostEqual32(d, Fsqrt32(26)) || a.DistanceSq(b) != 26 {
+		t.Errorf("Distance should be sqrt(26) but is %f", d)
+	}
+	if l := a.Lerp(b, 0.5); l != (Vec3{0.5, 1.5, 2}) {
+		t.Errorf("Halfway point should be %v but is %v", Vec3{0.5, 1.5, 2}, l)
+	}
+	if angle := a.Angle(b); !FalmostEqual32(angle, PI/2) {
+		t.Errorf("Angle should be Pi/2 but is %f", angle)
+	}
+	if angle := (Vec2{1, 1}).Angle(Vec2{-1, 0}); !FalmostEqual32(angle, PI*3/4) {
+		t.Errorf("Angle should be 3/4 Pi but is %f", angle)
+	}
+	if angle := (Vec4{1, 0, 0, 0}).Angle(Vec4{1, 1, 0, 0}); !FalmostEqual32(angle, PI/4) {
+		t.Errorf("Angle should be Pi/4 but is %f", angle)
+	}
+	if angle := (Vec4{}).Angle(Vec4{1, 1, 0, 0}); angle != 0 {
+		t.Errorf("Angle to a zero vector should be 0 but is %f", angle)
+	}
+
+	v := Vec3{2, 3, 4}
+	proj, rej := v.ProjectedOnto(b), v.RejectedFrom(b)
+	if !proj.AreEqual(&Vec3{0, 3, 4}) || !FalmostEqual32(rej.Dot(&b), 0) || proj.Plus(rej) != v {
+		t.Errorf("Projection and rejection should add up to v: %v + %v", proj, rej)
+	}
+	if proj := v.ProjectedOnto(Vec3{}); proj != (Vec3{}) {
+		t.Errorf("Projection onto zero should be zero but is %v", proj)
+	}
+
+	in, normal := Vec2{1, -1}.Normalized(), Vec2{0, 1}
+	if r := in.Reflected(normal); !r.AreEqual(&Vec2{in.X, -in.Y}) {
+		t.Errorf("Reflected direction should be %v but is %v", Vec2{in.X, -in.Y}, r)
+	}
+	if r := in.Refracted(normal, 1); !r.AreEqual(&in) {
+		t.Errorf("Refraction with equal indices should not bend but gives %v", r)
+	}
+	r := in.Refracted(normal, 1/1.5)
+	if sin := r.X / r.Length(); !FalmostEqual32(sin, in.X/1.5) || r.Y >= 0 {
+		t.Errorf("Refraction should follow Snell's law but gives %v", r)
+	}
+	if r := in.Refracted(normal, 1.5); r != (Vec2{}) {
+		t.Errorf("Total internal reflection should give zero but gives %v", r)
+	}
+
+	x, y := Vec4{1, -2, 3, -4}, Vec4{-1, 2, 2, -8}
+	if m := x.Min(y); m != (Vec4{-1, -2, 2, -8}) {
+		t.Errorf("Minimum should be %v but is %v", Vec4{-1, -2, 2, -8}, m)
+	}
+	if m := x.Max(y); m != (Vec4{1, 2, 3, -4}) {
+		t.Errorf("Maximum should be %v but is %v", Vec4{1, 2, 3, -4}, m)
+	}
+	if c := x.Clamp(Vec4{0, 0, 0, 0}, Vec4{2, 2, 2, 2}); c != (Vec4{1, 0, 2, 0}) {
+		t.Errorf("Clamped vector should be %v but is %v", Vec4{1, 0, 2, 0}, c)
+	}
+	if abs := x.Abs(); abs != (Vec4{1, 2, 3, 4}) {
+		t.Errorf("Absolute vector should be %v but is %v", Vec4{1, 2, 3, 4}, abs)
+	}
+	if m := x.Mul(y).Div(y); m != x {
+		t.Errorf("Multiplying and dividing should give %v but gives %v", x, m)
+	}
+
+	var zero Vec3
+	if n := zero.SafeNormalized(); n != zero || zero.SafeNormalize() {
+		t.Errorf("Safely normalized zero vector should stay zero but is %v", n)
+	}
+	tiny := Vec3{1e-30, 0, 0}
+	if !tiny.SafeNormalize() || tiny != (Vec3{1, 0, 0}) {
+		t.Errorf("Tiny vector should normalize to the X axis but is %v", tiny)
+	}
+}
+
+func TestVecConversions(t *testing.T) {
+	v2 := Vec2{1, 2}
+	v3 := v2.Vec3(3)
+	v4 := v3.Vec4(4)
+	if v3 != (Vec3{1, 2, 3}) || v4 != (Vec4{1, 2, 3, 4}) || v2.Vec4(3, 4) != v4 {
+		t.Errorf("Extended vectors should be (1, 2, 3) and (1, 2, 3, 4) but are %v and %v", v3, v4)
+	}
+	if v4.Vec3() != v3 || v4.PerspectiveDivide() != (Vec3{0.25, 0.5, 0.75}) {
+		t.Errorf("Vec4 should convert to %v or %v after the divide", v3, Vec3{0.25, 0.5, 0.75})
+	}
+
+	if v4.XY() != v2 || v4.YX() != (Vec2{2, 1}) || v4.WW() != (Vec2{4, 4}) {
+		t.Errorf("Vec2 swizzles of %v are wrong", v4)
+	}
+	if v3.ZYX() != (Vec3{3, 2, 1}) || v4.XZW() != (Vec3{1, 3, 4}) || v2.XYY() != (Vec3{1, 2, 2}) {
+		t.Errorf("Vec3 swizzles of %v are wrong", v4)
+	}
+	if v4.WZYX() != (Vec4{4, 3, 2, 1}) || v3.XYZX() != (Vec4{1, 2, 3, 1}) {
+		t.Errorf("Vec4 swizzles of %v are wrong", v4)
+	}
+
+	var m Mat4
+	m.Perspective(60, 1.5, 1, 100)
+	p := Vec3{1, 2, -10}
+	expected := p.Vec4(1).Transformed(&m).PerspectiveDivide()
+	p.TransformCoord(&m)
+	if !p.AreEqual(&expected) {
+		t.Errorf("TransformCoord should give %v but gives %v", expected, p)
+	}
+}
+
+func TestFloat64Types(t *testing.T) {
+	// Large world coordinates keep their fractions
+	v := Vec3d{1e8 + 0.25, -3e7 + 0.5, 0.125}
+	var m Mat4d
+	m.Translation(0.5, -0.5, 1)
+	v.Transform(&m)
+	if v != (Vec3d{1e8 + 0.75, -3e7, 1.125}) {
+		t.Errorf("Translated point should be exact but is %v", v)
+	}
+	// Changing the dimension keeps the precision
+	var w Vec3d = Vec4d{v.X, v.Y, v.Z, 1}.Vec3()
+	if w != v {
+		t.Errorf("Vec4d.Vec3 should give %v but gives %v", v, w)
+	}
+	if lossy := v.ToFloat32(); lossy.X != 1e8 || lossy.ToFloat64().ToFloat32() != lossy {
+		t.Errorf("Point rounded to float32 should lose its fractions but is %v", lossy)
+	}
+
+	var q Quaterniond
+	q.RotationAxisAngle(Vec3d{0, 0, 1}, math.Pi/2)
+	x := Vec3d{1, 0, 0}
+	x.Rotate(&q)
+	if math.Abs(x.X) > 1e-15 || math.Abs(x.Y-1) > 1e-15 {
+		t.Errorf("X axis rotated a quarter turn should be the Y axis within double precision but is %v", x)
+	}
+	if q32 := q.ToFloat32(); !FalmostEqual32(q32.W, float32(math.Sqrt(0.5))) || q32.ToFloat64().ToFloat32() != q32 {
+		t.Errorf("Quaternion should convert to single precision but is %v", q32)
+	}
+
+	var p Mat4d
+	p.Perspective(60, 1.5, 0.1, 1000)
+	inv := p
+	inv.Inverse()
+	inv.Multiply(&p)
+	var identity Mat4d
+	identity.Identity()
+	for i := range inv {
+		if math.Abs(inv[i]-identity[i]) > 1e-12 {
+			t.Errorf("Inverse times matrix should be the identity within double precision but is %v", inv)
+			break
+		}
+	}
+	if p32 := p.ToFloat32(); p32.ToFloat64() == p {
+		t.Errorf("Perspective matrix should lose precision when rounded to float32")
+	}
+}
+
+func TestGenericTypes(t *testing.T) {
+	// The concrete types are aliases of the generic ones
+	a := Vec3{1, -2, 3}
+	var ga Vec3Of[float32] = a
+	if n := ga.Normalized(); !n.AreEqual(&Vec3{1 / Fsqrt32(14), -2 / Fsqrt32(14), 3 / Fsqrt32(14)}) {
+		t.Errorf("Normalized generic vector is %v", n)
+	}
+	if d := ConvertVec3[float64](ga); d != a.ToFloat64() {
+		t.Errorf("Generic conversion to float64 should give %v but gives %v", a.ToFloat64(), d)
+	}
+	d := Vec3d{1, -2, 3}
+	d.Normalize()
+	if l := d.Length(); math.Abs(l-1) > 1e-15 {
+		t.Errorf("Normalized Vec3d should have length 1 within double precision but has %v", l)
+	}
+	c := Vec2Of[float64]{1, 2}
+	if c.Cross(); c != (Vec2Of[float64]{-2, 1}) {
+		t.Errorf("Crossed generic vector should be (-2, 1) but is %v", c)
+	}
+
+	// Integer vectors and matrices
+	p, q := Vec3i{1, -2, 3}, Vec3i{4, 5, -6}
+	if p.Plus(q) != (Vec3i{5, 3, -3}) || p.Crossed(q) != (Vec3i{-3, 18, 13}) || p.Dot(&q) != -24 {
+		t.Errorf("Integer vector operations are wrong")
+	}
+	if p.Abs() != (Vec3i{1, 2, 3}) || p.Clamp(Vec3i{0, 0, 0}, Vec3i{2, 2, 2}) != (Vec3i{1, 0, 2}) {
+		t.Errorf("Integer vector clamping is wrong")
+	}
+	if l := (&Vec2i{3, 4}).Length(); l != 5 {
+		t.Errorf("Length of (3, 4) should be 5 but is %d", l)
+	}
+	if !p.AreEqual(&p) || p.AreEqual(&Vec3i{1, -2, 4}) || p.String() != "Vec3(1, -2, 3)" {
+		t.Errorf("Integer vectors should only equal themselves and print without fractions: %v", p)
+	}
+	if u := (Vec4Of[uint8]{250, 1, 2, 3}).Max(Vec4Of[uint8]{1, 200, 2, 0}); u != (Vec4Of[uint8]{250, 200, 2, 3}) {
+		t.Errorf("Unsigned maximum is wrong: %v", u)
+	}
+	var mi Mat3Of[int]
+	mi.Identity()
+	mi[6], mi[7] = 5, -1
+	if w := (Vec2Of[int]{2, 3}).Transformed(&mi); w != (Vec2Of[int]{7, 2}) || mi.Determinant() != 1 {
+		t.Errorf("Integer translation should give (7, 2) but gives %v", w)
+	}
+	if n := (Vec3i{0, 5, 0}).Normalized(); n != (Vec3i{0, 1, 0}) {
+		t.Errorf("Integer vector along an axis should normalize to (0, 1, 0) but gives %v", n)
+	}
+	var zero Vec2i
+	if zero.Normalize(); zero != (Vec2i{}) || (Vec4i{1, 1, 0, 0}).Normalized() != (Vec4i{}) {
+		t.Errorf("Normalized integer vectors should be truncated towards zero")
+	}
+
+	// Types defined on float32 use the float32 approximations too
+	type meters float32
+	if s := sinOf(meters(1)); s != meters(Fsin32(1)) || formatOf(meters(0.5)) != "0.500000" {
+		t.Errorf("Types defined on float32 should use Fsin32 and print as floats")
+	}
+	if s := sinOf(1.0); s != math.Sin(1) {
+		t.Errorf("float64 should use math.Sin")
+	}
+	if c := ConvertVec2[int](Vec2Of[float32]{2.7, -1.5}); c != (Vec2Of[int]{2, -1}) {
+		t.Errorf("Converted vector should be truncated to (2, -1) but is %v", c)
+	}
 }
@@ -0,0 +1,77 @@
+package mathgl
+
+// Returns the Minkowski sum of the convex polygons p and q, the set of all
+// points a+b with a in p and b in q, as a clockwise convex Poly. Runs in
+// O(n+m) time by merging the edges of both polygons in order of their
+// direction. Single points and segments are allowed as degenerate polygons.
+func (p Poly) MinkowskiSum(q Poly) Poly {
+	if len(p) == 0 || len(q) == 0 {
+		return nil
+	}
+	a, b := minkowskiStart(p), minkowskiStart(q)
+	n, m := len(a), len(b)
+
+	sum := func(i, j int) Vec2 {
+		v := a[i%n]
+		v.Add(&b[j%m])
+		return v
+	}
+	edge := func(p Poly, i int) Vec2 {
+		e := p[(i+1)%len(p)]
+		e.Subtract(&p[i%len(p)])
+		return e
+	}
+
+	result := make(Poly, 0, n+m)
+	result = append(result, sum(0, 0))
+	for i, j := 0, 0; i < n || j < m; {
+		ea, eb := edge(a, i), edge(b, j)
+		c := cross2(&ea, &eb)
+		switch {
+		case j == m || (i < n && c < 0):
+			i++
+		case i == n || c > 0:
+			j++
+		default:
+			i++
+			j++
+		}
+		result = append(result, sum(i, j))
+	}
+	return removeCollinear(result[:len(result)-1])
+}
+
+// Returns the Minkowski difference of the convex polygons p and q, the set
+// of all points a-b with a in p and b in q, as a clockwise convex Poly. It
+// contains the origin exactly if p and q overlap, which is the basis of GJK
+// style collision tests.
+func (p Poly) MinkowskiDifference(q Poly) Poly {
+	negated := make(Poly, len(q))
+	for i := range q {
+		negated[i] = Vec2{-q[i].X, -q[i].Y}
+	}
+	return p.MinkowskiSum(negated)
+}
+
+// Returns a clockwise copy of the convex polygon starting at its lowest
+// vertex, the rightmost one if there are several. From there the edge
+// directions turn clockwise starting at the negative X axis.
+func minkowskiStart(p Poly) Poly {
+	start := 0
+	for i := range p {
+		if p[i].Y < p[start].Y || (p[i].Y == p[start].Y && p[i].X > p[start].X) {
+			start = i
+		}
+	}
+	clockwise := p.SignedArea() <= 0
+	n := len(p)
+	result := make(Poly, n)
+	for i := range result {
+		if clockwise {
+			result[i] = p[(start+i)%n]
+		} else {
+			result[i] = p[(start-i+n)%n]
+		}
+	}
+	return result
+}
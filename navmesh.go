@@ -0,0 +1,367 @@
+package mathgl
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// Navigation mesh made of convex cells covering the walkable area. Cells
+// share complete edges, called portals, with their neighbours.
+type NavMesh struct {
+	Cells []NavCell
+}
+
+// Convex, clockwise cell of a NavMesh.
+type NavCell struct {
+	Poly    Poly
+	Portals []NavPortal
+}
+
+// Edge of a cell leading into the neighbouring cell. Edge runs clockwise
+// along the cell, so seen from inside the cell A is on the left and B on the
+// right.
+type NavPortal struct {
+	Edge Seg2
+	Cell int
+}
+
+// Builds a navigation mesh covering outer minus the obstacles. The obstacles
+// may overlap each other and outer's boundary.
+//
+// The walkable area is triangulated, then neighbouring triangles are merged
+// as long as the cells stay convex, as in the Hertel–Mehlhorn algorithm.
+func NavMeshFromPoly(outer Poly, obstacles []Poly) NavMesh {
+	walkable := []PolyWithHoles{{Outer: outer}}
+	for _, obstacle := range obstacles {
+		walkable = PolyBoolean(walkable, []PolyWithHoles{{Outer: obstacle}}, POLY_DIFFERENCE)
+	}
+
+	// Triangulate, merging vertices at the same position so neighbouring
+	// triangles share their vertex indices.
+	var points []Vec2
+	index := make(map[Vec2]int)
+	var cells [][]int
+	for i := range walkable {
+		vertices := walkable[i].Vertices()
+		triangles := walkable[i].Triangulate()
+		for t := 0; t+2 < len(triangles); t += 3 {
+			cell := make([]int, 3)
+			for k := range cell {
+				v := vertices[triangles[t+k]]
+				idx, ok := index[v]
+				if !ok {
+					idx = len(points)
+					index[v] = idx
+					points = append(points, v)
+				}
+				cell[k] = idx
+			}
+			cells = append(cells, cell)
+		}
+	}
+
+	owner := make(map[[2]int]int)
+	for c, cell := range cells {
+		for k := range cell {
+			owner[[2]int{cell[k], cell[(k+1)%len(cell)]}] = c
+		}
+	}
+
+	// Remove the longest diagonals first, which tends to give fewer cells
+	var diagonals [][2]int
+	for edge := range owner {
+		if _, ok := owner[[2]int{edge[1], edge[0]}]; ok && edge[0] < edge[1] {
+			diagonals = append(diagonals, edge)
+		}
+	}
+	sort.Sort(diagonalsByLength{diagonals, points})
+	for _, d := range diagonals {
+		a, b := owner[d], owner[[2]int{d[1], d[0]}]
+		merged := mergeCells(cells[a], cells[b], d[0], d[1], points)
+		if merged == nil {
+			continue
+		}
+		cells[a], cells[b] = merged, nil
+		delete(owner, d)
+		delete(owner, [2]int{d[1], d[0]})
+		for k := range merged {
+			owner[[2]int{merged[k], merged[(k+1)%len(merged)]}] = a
+		}
+	}
+
+	// Number the remaining cells and connect them
+	var m NavMesh
+	number := make([]int, len(cells))
+	for c, cell := range cells {
+		number[c] = len(m.Cells)
+		if cell != nil {
+			poly := make(Poly, len(cell))
+			for k, idx := range cell {
+				poly[k] = points[idx]
+			}
+			m.Cells = append(m.Cells, NavCell{Poly: poly})
+		}
+	}
+	for c, cell := range cells {
+		for k := range cell {
+			u, v := cell[k], cell[(k+1)%len(cell)]
+			if other, ok := owner[[2]int{v, u}]; ok {
+				portal := NavPortal{Seg2{points[u], points[v]}, number[other]}
+				m.Cells[number[c]].Portals = append(m.Cells[number[c]].Portals, portal)
+			}
+		}
+	}
+	return m
+}
+
+// Returns the cell made of the cells a and b, which share the edge from u to
+// v, or nil if it wouldn't be convex.
+func mergeCells(a, b []int, u, v int, points []Vec2) []int {
+	// a runs from v around to u, b from u to v
+	var merged []int
+	for k := range a {
+		if a[k] == v {
+			merged = append(merged, a[k:]...)
+			merged = append(merged, a[:k]...)
+			break
+		}
+	}
+	for k := range b {
+		if b[k] == u {
+			for j := 1; j < len(b)-1; j++ {
+				merged = append(merged, b[(k+j)%len(b)])
+			}
+			break
+		}
+	}
+
+	// Only the corners at u and v change
+	for k, idx := range merged {
+		if idx != u && idx != v {
+			continue
+		}
+		prev := points[merged[(k+len(merged)-1)%len(merged)]]
+		next := points[merged[(k+1)%len(merged)]]
+		var e0, e1 Vec2
+		e0.Assign(&points[idx])
+		e0.Subtract(&prev)
+		e1.Assign(&next)
+		e1.Subtract(&points[idx])
+		if cross2(&e0, &e1) > 0 {
+			return nil
+		}
+	}
+	return merged
+}
+
+type diagonalsByLength struct {
+	diagonals [][2]int
+	points    []Vec2
+}
+
+func (d diagonalsByLength) length(i int) float32 {
+	v := d.points[d.diagonals[i][1]]
+	v.Subtract(&d.points[d.diagonals[i][0]])
+	return v.LengthSq()
+}
+
+func (d diagonalsByLength) Len() int { return len(d.diagonals) }
+func (d diagonalsByLength) Swap(i, j int) {
+	d.diagonals[i], d.diagonals[j] = d.diagonals[j], d.diagonals[i]
+}
+func (d diagonalsByLength) Less(i, j int) bool {
+	li, lj := d.length(i), d.length(j)
+	if li != lj {
+		return li > lj
+	}
+	a, b := d.diagonals[i], d.diagonals[j]
+	return a[0] < b[0] || (a[0] == b[0] && a[1] < b[1])
+}
+
+// Returns the index of the cell containing v, or -1 if v is not walkable.
+// Points on the border between cells belong to the first of them.
+func (m *NavMesh) CellAt(v *Vec2) int {
+	for c := range m.Cells {
+		if navCellContains(m.Cells[c].Poly, v) {
+			return c
+		}
+	}
+	return -1
+}
+
+func navCellContains(p Poly, v *Vec2) bool {
+	for i := range p {
+		a, b := &p[i], &p[(i+1)%len(p)]
+		e := Vec2{b.X - a.X, b.Y - a.Y}
+		d := Vec2{v.X - a.X, v.Y - a.Y}
+		if cross2(&e, &d) > 1e-6*Fmax32(1, e.LengthSq()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the shortest path from start to goal through the mesh, starting
+// with start and ending with goal. Returns nil if either point is not
+// walkable or the goal can't be reached.
+//
+// The cells are searched with A*, then the path through the portals
+// between them is pulled tight with the funnel algorithm.
+func (m *NavMesh) FindPath(start, goal Vec2) []Vec2 {
+	from, to := m.CellAt(&start), m.CellAt(&goal)
+	if from == -1 || to == -1 {
+		return nil
+	}
+	portals := m.findPortals(from, to, &start, &goal)
+	if portals == nil {
+		return nil
+	}
+	return funnel(portals, &start, &goal)
+}
+
+type navNode struct {
+	// Where the cell was entered
+	position Vec2
+	cost     float32
+	estimate float32
+	// Cell and portal it was entered through
+	parent, portal int
+	closed         bool
+	index          int
+}
+
+type navQueue struct {
+	nodes []navNode
+	open  []int
+}
+
+func (q *navQueue) Len() int { return len(q.open) }
+func (q *navQueue) Less(i, j int) bool {
+	return q.nodes[q.open[i]].estimate < q.nodes[q.open[j]].estimate
+}
+func (q *navQueue) Swap(i, j int) {
+	q.open[i], q.open[j] = q.open[j], q.open[i]
+	q.nodes[q.open[i]].index = i
+	q.nodes[q.open[j]].index = j
+}
+func (q *navQueue) Push(x interface{}) {
+	q.nodes[x.(int)].index = len(q.open)
+	q.open = append(q.open, x.(int))
+}
+func (q *navQueue) Pop() interface{} {
+	x := q.open[len(q.open)-1]
+	q.open = q.open[:len(q.open)-1]
+	q.nodes[x].index = -1
+	return x
+}
+
+func navDistance(a, b *Vec2) float32 {
+	d := *b
+	d.Subtract(a)
+	return d.Length()
+}
+
+// Returns the portals crossed on the way from cell from to cell to, or nil
+// if there is no way. Moving between cells costs the distance between the
+// midpoints of the portals.
+func (m *NavMesh) findPortals(from, to int, start, goal *Vec2) []Seg2 {
+	q := navQueue{nodes: make([]navNode, len(m.Cells))}
+	for i := range q.nodes {
+		q.nodes[i].index = -1
+		q.nodes[i].parent = -1
+	}
+	q.nodes[from].position = *start
+	q.nodes[from].estimate = navDistance(start, goal)
+	heap.Push(&q, from)
+
+	for q.Len() > 0 {
+		c := heap.Pop(&q).(int)
+		if c == to {
+			break
+		}
+		node := &q.nodes[c]
+		node.closed = true
+		for i, p := range m.Cells[c].Portals {
+			next := &q.nodes[p.Cell]
+			if next.closed {
+				continue
+			}
+			mid := p.Edge.At(0.5)
+			cost := node.cost + navDistance(&node.position, &mid)
+			if next.index != -1 && cost >= next.cost {
+				continue
+			}
+			next.position = mid
+			next.cost = cost
+			next.estimate = cost + navDistance(&mid, goal)
+			next.parent, next.portal = c, i
+			if next.index == -1 {
+				heap.Push(&q, p.Cell)
+			} else {
+				heap.Fix(&q, next.index)
+			}
+		}
+	}
+	if to != from && q.nodes[to].parent == -1 {
+		return nil
+	}
+
+	portals := []Seg2{}
+	for c := to; c != from; c = q.nodes[c].parent {
+		portals = append(portals, m.Cells[q.nodes[c].parent].Portals[q.nodes[c].portal].Edge)
+	}
+	for i, j := 0, len(portals)-1; i < j; i, j = i+1, j-1 {
+		portals[i], portals[j] = portals[j], portals[i]
+	}
+	return portals
+}
+
+// Pulls the path from start through the portals to goal tight. The portals
+// have their left end point in A and their right one in B.
+func funnel(portals []Seg2, start, goal *Vec2) []Vec2 {
+	portals = append(portals, Seg2{*goal, *goal})
+	path := []Vec2{*start}
+
+	// Returns the turn from the direction apex->a to apex->b, positive if b
+	// is to the left of a.
+	turn := func(apex, a, b *Vec2) float32 {
+		u := Vec2{a.X - apex.X, a.Y - apex.Y}
+		v := Vec2{b.X - apex.X, b.Y - apex.Y}
+		return cross2(&u, &v)
+	}
+
+	apex, left, right := *start, *start, *start
+	leftIndex, rightIndex := 0, 0
+	for i := 0; i < len(portals); i++ {
+		l, r := &portals[i].A, &portals[i].B
+
+		// Narrow the funnel from the right
+		if turn(&apex, &right, r) >= 0 {
+			if apex == right || turn(&apex, &left, r) < 0 {
+				right, rightIndex = *r, i
+			} else {
+				// The right side crossed the left one, which becomes the apex
+				path = append(path, left)
+				apex, right = left, left
+				i, rightIndex = leftIndex, leftIndex
+				continue
+			}
+		}
+
+		// Narrow the funnel from the left
+		if turn(&apex, &left, l) <= 0 {
+			if apex == left || turn(&apex, &right, l) > 0 {
+				left, leftIndex = *l, i
+			} else {
+				path = append(path, right)
+				apex, left = right, right
+				i, leftIndex = rightIndex, rightIndex
+				continue
+			}
+		}
+	}
+	if path[len(path)-1] != *goal {
+		path = append(path, *goal)
+	}
+	return path
+}
@@ -0,0 +1,247 @@
+package mathgl
+
+import "math"
+
+// How the offset edges are connected around the outside of a corner.
+type OffsetJoinEnum int
+
+const (
+	// Extends the edges until they meet. Corners sharper than the miter
+	// limit are squared off instead.
+	OFFSET_MITER OffsetJoinEnum = iota
+	// Connects the edges with an arc around the corner.
+	OFFSET_ROUND
+	// Cuts the corner off at the offset distance from the vertex.
+	OFFSET_SQUARE
+)
+
+const (
+	// Longest miter allowed, as a multiple of the offset distance
+	offsetMiterLimit = 2
+	// Segments used to approximate a full circle with round joins
+	offsetRoundSegments = 32
+)
+
+// Grows the polygon by distance, or shrinks it if distance is negative, and
+// returns the resulting area. Growing a concave polygon can close gaps and
+// create holes, while shrinking it can split it into several polygons or
+// make it vanish completely.
+//
+// The edges are moved along their normals and joined at the corners, then
+// the self-intersections of that outline are resolved by keeping the area it
+// winds around clockwise. The running time is O(n^2) for n vertices.
+func (p Poly) Offset(distance float32, join OffsetJoinEnum) []PolyWithHoles {
+	ring := make(Poly, len(p))
+	copy(ring, p)
+	if ring.SignedArea() > 0 {
+		ring.Reverse()
+	}
+	ring = removeCollinear(ring)
+	if len(ring) < 3 {
+		return nil
+	}
+	if distance == 0 {
+		return []PolyWithHoles{{Outer: ring}}
+	}
+
+	var pb polyBool
+	pb.tolerance = 1e-6 * Fmax32(1, Fabs32(distance))
+	for i := range ring {
+		pb.tolerance = Fmax32(pb.tolerance, 1e-6*Fmax32(Fabs32(ring[i].X), Fabs32(ring[i].Y)))
+	}
+
+	raw := offsetOutline(ring, distance, join)
+	indices := make([]int, 0, len(raw))
+	for i := range raw {
+		idx := pb.pointIndex(raw[i])
+		if len(indices) == 0 || indices[len(indices)-1] != idx {
+			indices = append(indices, idx)
+		}
+	}
+	for len(indices) > 1 && indices[0] == indices[len(indices)-1] {
+		indices = indices[:len(indices)-1]
+	}
+	if len(indices) < 3 {
+		return nil
+	}
+	for i := range indices {
+		pb.segs = append(pb.segs, polyBoolSeg{from: indices[i], to: indices[(i+1)%len(indices)]})
+	}
+	pb.splitSelf()
+
+	var pieces []polyBoolEdge
+	for i := range pb.segs {
+		pieces = pb.appendPieces(pieces, i)
+	}
+
+	// Coincident pieces are merged, counting how often the outline runs along
+	// them from the lower to the higher point index.
+	counts := make(map[[2]int]int)
+	var keys [][2]int
+	for _, e := range pieces {
+		key, count := [2]int{e.from, e.to}, 1
+		if e.from > e.to {
+			key, count = [2]int{e.to, e.from}, -1
+		}
+		if _, ok := counts[key]; !ok {
+			keys = append(keys, key)
+		}
+		counts[key] += count
+	}
+
+	// Keep the pieces separating area wound around clockwise from the rest,
+	// directed so that area is on their right.
+	var kept []polyBoolEdge
+	for _, key := range keys {
+		left := pb.windingLeftOf(pieces, key[0], key[1])
+		right := left + counts[key]
+		switch {
+		case left <= 0 && right > 0:
+			kept = append(kept, polyBoolEdge{from: key[0], to: key[1]})
+		case left > 0 && right <= 0:
+			kept = append(kept, polyBoolEdge{from: key[1], to: key[0]})
+		}
+	}
+	return assembleRings(pb.link(kept))
+}
+
+// Returns the outline made of the edges of the clockwise ring moved by
+// distance along their outward normals. Outer corners are connected with the
+// join, inner corners with a loop through the vertex which is resolved later.
+func offsetOutline(ring Poly, distance float32, join OffsetJoinEnum) Poly {
+	r := Fabs32(distance)
+	n := len(ring)
+	dirs := make([]Vec2, n)
+	normals := make([]Vec2, n)
+	for i := range ring {
+		dirs[i].Assign(&ring[(i+1)%n])
+		dirs[i].Subtract(&ring[i])
+		dirs[i].Normalize()
+		// Outward for clockwise rings, inward when shrinking
+		normals[i].Assign(&dirs[i])
+		normals[i].Cross()
+		if distance < 0 {
+			normals[i].Scale(-1)
+		}
+	}
+
+	var out Poly
+	at := func(v *Vec2, a Vec2, s float32) Vec2 {
+		a.Scale(s)
+		a.Add(v)
+		return a
+	}
+	for i := range ring {
+		v := &ring[i]
+		prev := (i + n - 1) % n
+		u0, u1 := normals[prev], normals[i]
+		e0, e1 := dirs[prev], dirs[i]
+		turn := cross2(&e0, &e1)
+		if distance < 0 {
+			turn = -turn
+		}
+
+		// Inner corner, or straight continuation
+		if turn > 0 || (turn == 0 && e0.Dot(&e1) > 0) {
+			if turn != 0 {
+				out = append(out, at(v, u0, r), *v)
+			}
+			out = append(out, at(v, u1, r))
+			continue
+		}
+
+		// Outer corner, the bisector points away from the vertex
+		b := u0
+		b.Add(&u1)
+		reversed := b.LengthSq() < 1e-12
+		if reversed {
+			b = e0
+		} else {
+			b.Normalize()
+		}
+		cos := u0.Dot(&u1)
+		switch {
+		case join == OFFSET_MITER && 1+cos > 2/(offsetMiterLimit*offsetMiterLimit):
+			// The miter length is r / cos(angle/2) = r * sqrt(2 / (1+cos))
+			out = append(out, at(v, b, r*Fsqrt32(2/(1+cos))))
+		case join == OFFSET_ROUND:
+			angle := math.Atan2(float64(cross2(&u0, &u1)), float64(cos))
+			if reversed {
+				angle = math.Copysign(math.Pi, float64(cross2(&u0, &e0)))
+			}
+			steps := int(math.Ceil(math.Abs(angle) / (2 * math.Pi / offsetRoundSegments)))
+			for k := 0; k <= steps; k++ {
+				a := angle * float64(k) / float64(steps)
+				sin, cos := math.Sincos(a)
+				rotated := Vec2{u0.X*float32(cos) - u0.Y*float32(sin), u0.X*float32(sin) + u0.Y*float32(cos)}
+				out = append(out, at(v, rotated, r))
+			}
+		default:
+			// Square, also used for miters exceeding the limit
+			p0 := at(v, u0, r)
+			p1 := at(v, u1, r)
+			t0 := r * (1 - u0.Dot(&b)) / e0.Dot(&b)
+			t1 := r * (1 - u1.Dot(&b)) / -e1.Dot(&b)
+			out = append(out, at(&p0, e0, t0), at(&p1, e1, -t1))
+		}
+	}
+	return out
+}
+
+// Finds where the segments of a single outline cross or touch each other.
+func (pb *polyBool) splitSelf() {
+	for i := range pb.segs {
+		for j := i + 1; j < len(pb.segs); j++ {
+			u, v := &pb.segs[i], &pb.segs[j]
+			su := Seg2{pb.points[u.from], pb.points[u.to]}
+			sv := Seg2{pb.points[v.from], pb.points[v.to]}
+			r := su.SegIsect(&sv)
+			switch r.Kind {
+			case SEGMENTS_INTERSECT:
+				pb.addSplit(u, v, &su, &sv, r.S, r.T)
+			case SEGMENTS_OVERLAP:
+				pb.addSplit(u, v, &su, &sv, r.S, r.T)
+				pb.addSplit(u, v, &su, &sv, r.S1, r.T1)
+			}
+		}
+	}
+}
+
+// Returns how often the outline made of the pieces winds clockwise around
+// the area just left of the line from point from to point to. It counts the
+// other pieces crossing a ray from the middle of the line along its left
+// normal.
+func (pb *polyBool) windingLeftOf(pieces []polyBoolEdge, from, to int) int {
+	a, b := &pb.points[from], &pb.points[to]
+	origin := Vec2{(a.X + b.X) * 0.5, (a.Y + b.Y) * 0.5}
+	dir := Vec2{b.X - a.X, b.Y - a.Y}
+	dir.Cross()
+
+	winding := 0
+	for j := range pieces {
+		if piece := &pieces[j]; (piece.from == from && piece.to == to) || (piece.from == to && piece.to == from) {
+			continue
+		}
+		var p, q Vec2
+		p.Assign(&pb.points[pieces[j].from])
+		p.Subtract(&origin)
+		q.Assign(&pb.points[pieces[j].to])
+		q.Subtract(&origin)
+		sp, sq := cross2(&dir, &p), cross2(&dir, &q)
+		if (sp > 0) == (sq > 0) {
+			continue
+		}
+		// Distance along the ray, scaled by the sign of sq-sp
+		var e Vec2
+		e.Assign(&q)
+		e.Subtract(&p)
+		if t := cross2(&p, &e); (t > 0) == (sq > sp) && t != 0 {
+			if sq > sp {
+				winding--
+			} else {
+				winding++
+			}
+		}
+	}
+	return winding
+}
@@ -19,6 +19,186 @@ const (
 	POINT_ON_PLANE
 )
 
-type Plane struct {
-	A, B, C, D float32
+// Plane given by the equation A*x + B*y + C*z + D = 0. Integer element types
+// truncate the distances and normalization.
+type PlaneOf[T Number] struct {
+	A, B, C, D T
+}
+
+// Planes with float32 and float64 elements.
+type (
+	Plane  = PlaneOf[float32]
+	Planed = PlaneOf[float64]
+)
+
+// Returns A*x + B*y + C*z + D for the given Vec3, which is the signed distance
+// from the plane if the plane is normalized.
+func (p *PlaneOf[T]) DotCoord(v *Vec3Of[T]) T {
+	return p.A*v.X + p.B*v.Y + p.C*v.Z + p.D
+}
+
+// Returns the (not necessarily normalized) normal of the plane.
+func (p *PlaneOf[T]) Normal() Vec3Of[T] {
+	return Vec3Of[T]{p.A, p.B, p.C}
+}
+
+// Sets the plane to the plane through the three given points. The normal
+// points towards the side from which the points appear counter-clockwise.
+func (p *PlaneOf[T]) FromPoints(a, b, c *Vec3Of[T]) {
+	var n, ac Vec3Of[T]
+	n.Assign(b)
+	n.Subtract(a)
+	ac.Assign(c)
+	ac.Subtract(a)
+	n.Cross(&ac)
+
+	p.FromPointNormal(a, &n)
+}
+
+// Sets the plane to the plane through the given point with the given normal.
+func (p *PlaneOf[T]) FromPointNormal(point, normal *Vec3Of[T]) {
+	var n Vec3Of[T]
+	n.Assign(normal)
+	n.Normalize()
+
+	p.A = n.X
+	p.B = n.Y
+	p.C = n.Z
+	p.D = -n.Dot(point)
+}
+
+// Normalizes the plane, so that its normal has length 1.
+func (p *PlaneOf[T]) Normalize() {
+	n := p.Normal()
+	var l T = 1.0 / n.Length()
+	p.A *= l
+	p.B *= l
+	p.C *= l
+	p.D *= l
+}
+
+// Returns the signed distance of the given Vec3 from the plane. It is positive
+// on the side the normal points to.
+func (p *PlaneOf[T]) Distance(v *Vec3Of[T]) T {
+	n := p.Normal()
+	return p.DotCoord(v) / n.Length()
+}
+
+// Returns on which side of the plane the given Vec3 lies.
+func (p *PlaneOf[T]) ClassifyPoint(v *Vec3Of[T]) PointClassificationEnum {
+	const tolerance = 0.001
+
+	d := float64(p.Distance(v))
+	if d > tolerance {
+		return POINT_INFRONT_OF_PLANE
+	}
+	if d < -tolerance {
+		return POINT_BEHIND_PLANE
+	}
+	return POINT_ON_PLANE
+}
+
+// Returns the point on the plane which is closest to the given Vec3.
+func (p *PlaneOf[T]) ProjectPoint(v *Vec3Of[T]) Vec3Of[T] {
+	n := p.Normal()
+	result := *v
+	n.Scale(-p.DotCoord(v) / n.LengthSq())
+	result.Add(&n)
+	return result
+}
+
+// Transforms the plane by the given Mat4, using the inverse transpose of the
+// matrix. Returns false if the matrix can't be inverted.
+func (p *PlaneOf[T]) Transform(m *Mat4Of[T]) bool {
+	var inv Mat4Of[T]
+	inv.Assign(m)
+	if !inv.Inverse() {
+		return false
+	}
+	inv.Transpose()
+
+	var t Vec4Of[T]
+	t.Fill(p.A, p.B, p.C, p.D)
+	t.Transform(&inv)
+
+	p.A = t.X
+	p.B = t.Y
+	p.C = t.Z
+	p.D = t.W
+	p.Normalize()
+	return true
+}
+
+// Returns the distance along the given direction at which the line through
+// origin hits the plane. Returns false if the line is parallel to the plane.
+func (p *PlaneOf[T]) intersectLine(origin, direction *Vec3Of[T]) (T, bool) {
+	n := p.Normal()
+	denom := n.Dot(direction)
+	if float64(absOf(denom)) < 1e-6 {
+		return 0.0, false
+	}
+	return -p.DotCoord(origin) / denom, true
+}
+
+// Returns the point where the ray from origin along direction hits the plane
+// and the distance along the ray in units of direction. Returns false if the
+// ray is parallel to the plane or points away from it.
+func (p *PlaneOf[T]) IntersectRay(origin, direction *Vec3Of[T]) (Vec3Of[T], T, bool) {
+	t, ok := p.intersectLine(origin, direction)
+	if !ok || t < 0.0 {
+		return Vec3Of[T]{}, 0.0, false
+	}
+
+	point := *direction
+	point.Scale(t)
+	point.Add(origin)
+	return point, t, true
+}
+
+// Returns the point where the segment from a to b crosses the plane. Returns
+// false if the segment doesn't reach the plane.
+func (p *PlaneOf[T]) IntersectSegment(a, b *Vec3Of[T]) (Vec3Of[T], bool) {
+	var direction Vec3Of[T]
+	direction.Assign(b)
+	direction.Subtract(a)
+
+	t, ok := p.intersectLine(a, &direction)
+	if !ok || t < 0.0 || t > 1.0 {
+		return Vec3Of[T]{}, false
+	}
+
+	point := direction
+	point.Scale(t)
+	point.Add(a)
+	return point, true
+}
+
+// Returns the single point shared by the three planes. Returns false if two of
+// the planes are parallel or the planes share a line.
+func IntersectPlanes[T Number](p1, p2, p3 *PlaneOf[T]) (Vec3Of[T], bool) {
+	n1, n2, n3 := p1.Normal(), p2.Normal(), p3.Normal()
+
+	var c23, c31, c12 Vec3Of[T]
+	c23.Assign(&n2)
+	c23.Cross(&n3)
+	c31.Assign(&n3)
+	c31.Cross(&n1)
+	c12.Assign(&n1)
+	c12.Cross(&n2)
+
+	denom := n1.Dot(&c23)
+	if float64(absOf(denom)) < 1e-6 {
+		return Vec3Of[T]{}, false
+	}
+
+	c23.Scale(-p1.D)
+	c31.Scale(-p2.D)
+	c12.Scale(-p3.D)
+
+	var point Vec3Of[T]
+	point.Add(&c23)
+	point.Add(&c31)
+	point.Add(&c12)
+	point.Scale(1.0 / denom)
+	return point, true
 }
@@ -0,0 +1,193 @@
+package mathgl
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	ErrPolyTooFewVertices   = errors.New("mathgl: polygon needs at least 3 vertices")
+	ErrPolyCounterClockwise = errors.New("mathgl: polygon is counter-clockwise, but must be clockwise (use Poly.Reverse)")
+	ErrPolyNotSimple        = errors.New("mathgl: polygon boundary intersects itself")
+	ErrPolyNotConvex        = errors.New("mathgl: polygon is not convex")
+)
+
+// Returns nil if the polygon can be used with the functions expecting a Poly:
+// it needs at least 3 vertices, must be clockwise and must not intersect
+// itself. Clip additionally needs a convex polygon, which IsConvex checks, and
+// returns ErrPolyNotConvex otherwise.
+func (p Poly) Validate() error {
+	if len(p) < 3 {
+		return ErrPolyTooFewVertices
+	}
+	if !p.IsClockwise() {
+		return ErrPolyCounterClockwise
+	}
+	if !p.IsSimple() {
+		return ErrPolyNotSimple
+	}
+	return nil
+}
+
+// Returns the signed area of the polygon, which is negative for clockwise
+// polygons.
+func (p Poly) SignedArea() float32 {
+	var area float32
+	for i, j := 0, len(p)-1; i < len(p); j, i = i, i+1 {
+		area += p[j].X*p[i].Y - p[i].X*p[j].Y
+	}
+	return area * 0.5
+}
+
+// Returns the area of the polygon.
+func (p Poly) Area() float32 {
+	return Fabs32(p.SignedArea())
+}
+
+// Returns true if the vertices of the polygon are in clockwise order.
+func (p Poly) IsClockwise() bool {
+	return p.SignedArea() < 0
+}
+
+// Reverses the order of the vertices, turning a clockwise polygon into a
+// counter-clockwise one and vice versa.
+func (p Poly) Reverse() {
+	for i, j := 0, len(p)-1; i < j; i, j = i+1, j-1 {
+		p[i], p[j] = p[j], p[i]
+	}
+}
+
+// Returns the center of mass of the polygon area.
+func (p Poly) Centroid() Vec2 {
+	var c Vec2
+	var area float32
+	for i, j := 0, len(p)-1; i < len(p); j, i = i, i+1 {
+		f := p[j].X*p[i].Y - p[i].X*p[j].Y
+		area += f
+		c.X += (p[j].X + p[i].X) * f
+		c.Y += (p[j].Y + p[i].Y) * f
+	}
+	if area == 0 {
+		// Degenerate polygon, fall back to the average of the vertices
+		c.Zero()
+		for i := range p {
+			c.Add(&p[i])
+		}
+		if len(p) > 0 {
+			c.Scale(1.0 / float32(len(p)))
+		}
+		return c
+	}
+	c.Scale(1.0 / (3.0 * area))
+	return c
+}
+
+// Returns the length of the boundary of the polygon.
+func (p Poly) Perimeter() float32 {
+	var perimeter float32
+	for i, j := 0, len(p)-1; i < len(p); j, i = i, i+1 {
+		edge := p[i]
+		edge.Subtract(&p[j])
+		perimeter += edge.Length()
+	}
+	return perimeter
+}
+
+// Returns true if the polygon is convex. Collinear vertices are allowed.
+func (p Poly) IsConvex() bool {
+	var sign float32
+	// A star turns the same way at every vertex too, but a convex polygon
+	// turns around only once
+	var turning float64
+	for i := range p {
+		a, b, c := &p[i], &p[(i+1)%len(p)], &p[(i+2)%len(p)]
+		turn := (b.X-a.X)*(c.Y-b.Y) - (b.Y-a.Y)*(c.X-b.X)
+		if turn == 0 {
+			continue
+		}
+		if sign != 0 && (turn > 0) != (sign > 0) {
+			return false
+		}
+		sign = turn
+		turning += math.Atan2(float64(turn), float64((b.X-a.X)*(c.X-b.X)+(b.Y-a.Y)*(c.Y-b.Y)))
+	}
+	return math.Abs(turning) < 3*math.Pi
+}
+
+// Returns how many times the boundary of the polygon winds around v. It is
+// negative for clockwise polygons and zero for points outside.
+func (p Poly) WindingNumber(v *Vec2) int {
+	winding := 0
+	for i, j := 0, len(p)-1; i < len(p); j, i = i, i+1 {
+		a, b := &p[j], &p[i]
+		side := (b.X-a.X)*(v.Y-a.Y) - (v.X-a.X)*(b.Y-a.Y)
+		if a.Y <= v.Y {
+			if b.Y > v.Y && side > 0 {
+				winding++
+			}
+		} else if b.Y <= v.Y && side < 0 {
+			winding--
+		}
+	}
+	return winding
+}
+
+// Returns true if v lies inside the polygon. Works for concave polygons of
+// either winding.
+func (p Poly) Contains(v *Vec2) bool {
+	return p.WindingNumber(v) != 0
+}
+
+// Returns the point on the boundary of the polygon closest to v.
+func (p Poly) ClosestPoint(v *Vec2) Vec2 {
+	var best Vec2
+	var bestDist float32 = -1
+	for i, j := 0, len(p)-1; i < len(p); j, i = i, i+1 {
+		c := Seg2{p[j], p[i]}.ClosestPoint(v)
+		d := c
+		d.Subtract(v)
+		if dist := d.LengthSq(); bestDist < 0 || dist < bestDist {
+			best = c
+			bestDist = dist
+		}
+	}
+	return best
+}
+
+// Returns true if no two edges of the polygon intersect, apart from
+// neighbouring edges sharing their common vertex. Consecutive vertices may be
+// collinear, but the boundary must not turn back on itself.
+func (p Poly) IsSimple() bool {
+	n := len(p)
+	for i := 0; i < n; i++ {
+		u := Seg2{p[i], p[(i+1)%n]}
+		for j := i + 1; j < n; j++ {
+			// Neighbouring edges meet in their shared vertex anyway and only
+			// overlap if the boundary turns back there
+			if j == i+1 {
+				if turnsBack(&p[i], &p[j], &p[(j+1)%n]) {
+					return false
+				}
+				continue
+			}
+			if i == 0 && j == n-1 {
+				if turnsBack(&p[j], &p[0], &p[1]) {
+					return false
+				}
+				continue
+			}
+			v := Seg2{p[j], p[(j+1)%n]}
+			if r := u.SegIsect(&v); r.Kind != SEGMENTS_DISJOINT {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Returns true if the path from a over b to c goes straight back along itself
+// at b.
+func turnsBack(a, b, c *Vec2) bool {
+	d, e := b.Minus(*a), c.Minus(*b)
+	return d.X*e.Y-d.Y*e.X == 0 && d.Dot(&e) < 0
+}
@@ -0,0 +1,412 @@
+package mathgl
+
+import (
+	"math"
+	"sort"
+)
+
+// Polygon with holes. Outer is clockwise like every Poly, the holes are
+// counter-clockwise and lie inside Outer.
+type PolyWithHoles struct {
+	Outer Poly
+	Holes []Poly
+}
+
+type PolyBooleanEnum int
+
+const (
+	POLY_UNION PolyBooleanEnum = iota
+	POLY_INTERSECTION
+	POLY_DIFFERENCE
+	POLY_XOR
+)
+
+// Returns the union of the two polygons.
+func (p Poly) Union(q Poly) []PolyWithHoles {
+	return PolyBoolean([]PolyWithHoles{{Outer: p}}, []PolyWithHoles{{Outer: q}}, POLY_UNION)
+}
+
+// Returns the area covered by both polygons.
+func (p Poly) Intersection(q Poly) []PolyWithHoles {
+	return PolyBoolean([]PolyWithHoles{{Outer: p}}, []PolyWithHoles{{Outer: q}}, POLY_INTERSECTION)
+}
+
+// Returns the area covered by p but not by q.
+func (p Poly) Difference(q Poly) []PolyWithHoles {
+	return PolyBoolean([]PolyWithHoles{{Outer: p}}, []PolyWithHoles{{Outer: q}}, POLY_DIFFERENCE)
+}
+
+// Returns the area covered by exactly one of the polygons.
+func (p Poly) Xor(q Poly) []PolyWithHoles {
+	return PolyBoolean([]PolyWithHoles{{Outer: p}}, []PolyWithHoles{{Outer: q}}, POLY_XOR)
+}
+
+// Computes the given boolean operation between the areas a and b. Both may
+// be concave and have holes, but their boundaries must not intersect
+// themselves. The winding of the input rings is fixed up if necessary, the
+// result follows the PolyWithHoles conventions and has no collinear vertices.
+//
+// All edges are split where they cross or touch the other operand, every
+// piece is classified as inside, outside or shared with the other operand,
+// and the pieces that bound the result are linked into rings again. The
+// running time is O((n+m)^2) for n and m vertices.
+func PolyBoolean(a, b []PolyWithHoles, op PolyBooleanEnum) []PolyWithHoles {
+	var pb polyBool
+	pb.addOperand(a, 0)
+	pb.addOperand(b, 1)
+	pb.split()
+
+	// Classify the pieces of every edge against the other operand
+	var pieces []polyBoolEdge
+	for i := range pb.segs {
+		pieces = pb.appendPieces(pieces, i)
+	}
+	directed := make(map[[2]int]int, len(pieces))
+	for _, e := range pieces {
+		directed[[2]int{e.from, e.to}] |= 1 << uint(e.owner)
+	}
+
+	var kept []polyBoolEdge
+	for _, e := range pieces {
+		other := 1 - e.owner
+		same := directed[[2]int{e.from, e.to}]&(1<<uint(other)) != 0
+		opposite := directed[[2]int{e.to, e.from}]&(1<<uint(other)) != 0
+
+		if same || opposite {
+			// Shared edges are decided once, by the first operand
+			if e.owner != 0 {
+				continue
+			}
+			switch {
+			case same && (op == POLY_UNION || op == POLY_INTERSECTION):
+				kept = append(kept, e)
+			case opposite && op == POLY_DIFFERENCE:
+				kept = append(kept, e)
+			}
+			continue
+		}
+
+		mid := Vec2{(pb.points[e.from].X + pb.points[e.to].X) * 0.5, (pb.points[e.from].Y + pb.points[e.to].Y) * 0.5}
+		inside := pb.inside(&mid, other)
+		switch op {
+		case POLY_UNION:
+			if !inside {
+				kept = append(kept, e)
+			}
+		case POLY_INTERSECTION:
+			if inside {
+				kept = append(kept, e)
+			}
+		case POLY_DIFFERENCE:
+			if e.owner == 0 && !inside {
+				kept = append(kept, e)
+			} else if e.owner == 1 && inside {
+				kept = append(kept, polyBoolEdge{e.to, e.from, e.owner})
+			}
+		case POLY_XOR:
+			if inside {
+				e.from, e.to = e.to, e.from
+			}
+			kept = append(kept, e)
+		}
+	}
+
+	return assembleRings(pb.link(kept))
+}
+
+type polyBoolSeg struct {
+	from, to int
+	owner    int
+	splits   []polyBoolSplit
+}
+
+type polyBoolSplit struct {
+	t     float32
+	point int
+}
+
+type polyBoolEdge struct {
+	from, to int
+	owner    int
+}
+
+type polyBool struct {
+	points    []Vec2
+	segs      []polyBoolSeg
+	rings     [2][]Poly
+	tolerance float32
+}
+
+// Returns the index of the point, merging it with an existing point closer
+// than the tolerance.
+func (pb *polyBool) pointIndex(v Vec2) int {
+	for i := range pb.points {
+		if Fabs32(pb.points[i].X-v.X) <= pb.tolerance && Fabs32(pb.points[i].Y-v.Y) <= pb.tolerance {
+			return i
+		}
+	}
+	pb.points = append(pb.points, v)
+	return len(pb.points) - 1
+}
+
+func (pb *polyBool) addOperand(polys []PolyWithHoles, owner int) {
+	for i := range polys {
+		pb.addRing(polys[i].Outer, owner, false)
+		for _, hole := range polys[i].Holes {
+			pb.addRing(hole, owner, true)
+		}
+	}
+}
+
+func (pb *polyBool) addRing(p Poly, owner int, hole bool) {
+	if pb.tolerance == 0 {
+		pb.tolerance = 1e-6
+	}
+	for i := range p {
+		pb.tolerance = Fmax32(pb.tolerance, 1e-6*Fmax32(Fabs32(p[i].X), Fabs32(p[i].Y)))
+	}
+
+	// Clockwise outer rings and counter-clockwise holes keep the filled area
+	// on the right hand side of every edge.
+	ring := make(Poly, len(p))
+	copy(ring, p)
+	if (ring.SignedArea() > 0) != hole {
+		ring.Reverse()
+	}
+	pb.rings[owner] = append(pb.rings[owner], ring)
+
+	indices := make([]int, 0, len(ring))
+	for i := range ring {
+		idx := pb.pointIndex(ring[i])
+		if len(indices) == 0 || indices[len(indices)-1] != idx {
+			indices = append(indices, idx)
+		}
+	}
+	for len(indices) > 1 && indices[0] == indices[len(indices)-1] {
+		indices = indices[:len(indices)-1]
+	}
+	if len(indices) < 3 {
+		return
+	}
+	for i := range indices {
+		pb.segs = append(pb.segs, polyBoolSeg{from: indices[i], to: indices[(i+1)%len(indices)], owner: owner})
+	}
+}
+
+// Finds where the segments of the two operands cross or touch each other.
+func (pb *polyBool) split() {
+	for i := range pb.segs {
+		for j := range pb.segs {
+			u, v := &pb.segs[i], &pb.segs[j]
+			if u.owner != 0 || v.owner != 1 {
+				continue
+			}
+			su := Seg2{pb.points[u.from], pb.points[u.to]}
+			sv := Seg2{pb.points[v.from], pb.points[v.to]}
+			r := su.SegIsect(&sv)
+			switch r.Kind {
+			case SEGMENTS_INTERSECT:
+				pb.addSplit(u, v, &su, &sv, r.S, r.T)
+			case SEGMENTS_OVERLAP:
+				pb.addSplit(u, v, &su, &sv, r.S, r.T)
+				pb.addSplit(u, v, &su, &sv, r.S1, r.T1)
+			}
+		}
+	}
+}
+
+func (pb *polyBool) addSplit(u, v *polyBoolSeg, su, sv *Seg2, s, t float32) {
+	var idx int
+	switch {
+	case s <= 0:
+		idx = u.from
+	case s >= 1:
+		idx = u.to
+	case t <= 0:
+		idx = v.from
+	case t >= 1:
+		idx = v.to
+	default:
+		idx = pb.pointIndex(su.At(s))
+	}
+	u.splits = append(u.splits, polyBoolSplit{s, idx})
+	v.splits = append(v.splits, polyBoolSplit{t, idx})
+}
+
+// Appends the pieces the segment is split into.
+func (pb *polyBool) appendPieces(pieces []polyBoolEdge, i int) []polyBoolEdge {
+	seg := &pb.segs[i]
+	sort.Sort(splitsByT(seg.splits))
+	from := seg.from
+	for _, s := range seg.splits {
+		if s.point != from && s.point != seg.to {
+			pieces = append(pieces, polyBoolEdge{from, s.point, seg.owner})
+			from = s.point
+		}
+	}
+	return append(pieces, polyBoolEdge{from, seg.to, seg.owner})
+}
+
+type splitsByT []polyBoolSplit
+
+func (s splitsByT) Len() int           { return len(s) }
+func (s splitsByT) Less(i, j int) bool { return s[i].t < s[j].t }
+func (s splitsByT) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// Returns true if the point lies inside the area of the given operand.
+func (pb *polyBool) inside(v *Vec2, owner int) bool {
+	inside := false
+	for _, ring := range pb.rings[owner] {
+		if ring.Contains(v) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// Links the directed edges into closed rings. Where several edges leave a
+// vertex the one turning right the most is taken, which keeps rings that only
+// touch in a vertex apart.
+func (pb *polyBool) link(edges []polyBoolEdge) []Poly {
+	outgoing := make(map[int][]int)
+	for i, e := range edges {
+		outgoing[e.from] = append(outgoing[e.from], i)
+	}
+	used := make([]bool, len(edges))
+
+	var rings []Poly
+	for start := range edges {
+		if used[start] {
+			continue
+		}
+		used[start] = true
+		ring := []int{edges[start].from}
+		current := start
+		for edges[current].to != edges[start].from {
+			next := pb.nextEdge(edges, outgoing[edges[current].to], used, &edges[current])
+			if next == -1 {
+				ring = nil
+				break
+			}
+			used[next] = true
+			ring = append(ring, edges[next].from)
+			current = next
+		}
+		if ring == nil {
+			continue
+		}
+
+		poly := make(Poly, len(ring))
+		for i, idx := range ring {
+			poly[i] = pb.points[idx]
+		}
+		poly = removeCollinear(poly)
+		if len(poly) >= 3 && Fabs32(poly.SignedArea()) > pb.tolerance*pb.tolerance {
+			rings = append(rings, poly)
+		}
+	}
+	return rings
+}
+
+func (pb *polyBool) nextEdge(edges []polyBoolEdge, candidates []int, used []bool, in *polyBoolEdge) int {
+	var dirIn Vec2
+	dirIn.Assign(&pb.points[in.to])
+	dirIn.Subtract(&pb.points[in.from])
+
+	best := -1
+	bestAngle := math.Inf(1)
+	for _, c := range candidates {
+		if used[c] {
+			continue
+		}
+		var dirOut Vec2
+		dirOut.Assign(&pb.points[edges[c].to])
+		dirOut.Subtract(&pb.points[edges[c].from])
+		angle := math.Atan2(float64(cross2(&dirIn, &dirOut)), float64(dirIn.Dot(&dirOut)))
+		if angle < bestAngle {
+			best = c
+			bestAngle = angle
+		}
+	}
+	return best
+}
+
+// Sorts rings into clockwise outer boundaries and counter-clockwise holes,
+// and puts every hole into the smallest outer boundary containing it.
+func assembleRings(rings []Poly) []PolyWithHoles {
+	var result []PolyWithHoles
+	var holes []Poly
+	for _, ring := range rings {
+		if ring.SignedArea() < 0 {
+			result = append(result, PolyWithHoles{Outer: ring})
+		} else {
+			holes = append(holes, ring)
+		}
+	}
+
+	for _, hole := range holes {
+		best := -1
+		var bestArea float32
+		for i := range result {
+			if !ringInside(hole, result[i].Outer) {
+				continue
+			}
+			area := -result[i].Outer.SignedArea()
+			if best == -1 || area < bestArea {
+				best = i
+				bestArea = area
+			}
+		}
+		if best != -1 {
+			result[best].Holes = append(result[best].Holes, hole)
+		}
+	}
+	return result
+}
+
+// Returns true if the ring lies inside the outer ring, assuming the two
+// boundaries don't cross.
+func ringInside(ring, outer Poly) bool {
+	for i := range ring {
+		mid := Vec2{(ring[i].X + ring[(i+1)%len(ring)].X) * 0.5, (ring[i].Y + ring[(i+1)%len(ring)].Y) * 0.5}
+		if onBoundary(outer, &mid) {
+			continue
+		}
+		return outer.Contains(&mid)
+	}
+	return false
+}
+
+func onBoundary(p Poly, v *Vec2) bool {
+	for i := range p {
+		edge := Seg2{p[i], p[(i+1)%len(p)]}
+		r := edge.Ray()
+		if edge.DistFromPoint(v) < 1e-6*Fmax32(1, r.Length()) {
+			return true
+		}
+	}
+	return false
+}
+
+// Removes duplicate vertices and vertices lying on the line through their
+// neighbours.
+func removeCollinear(p Poly) Poly {
+	for changed := true; changed && len(p) >= 3; {
+		changed = false
+		for i := 0; i < len(p) && len(p) >= 3; i++ {
+			prev := &p[(i+len(p)-1)%len(p)]
+			next := &p[(i+1)%len(p)]
+			var a, b Vec2
+			a.Assign(&p[i])
+			a.Subtract(prev)
+			b.Assign(next)
+			b.Subtract(&p[i])
+			if Fabs32(cross2(&a, &b)) <= 1e-6*Fsqrt32(a.LengthSq()*b.LengthSq()) {
+				p = append(p[:i], p[i+1:]...)
+				changed = true
+				i--
+			}
+		}
+	}
+	return p
+}
@@ -0,0 +1,91 @@
+package mathgl
+
+// Conversions between the precisions. They keep the dimension and only change
+// the element type, unlike Vec4.Vec3 and friends. ToFloat64 is lossless for
+// float32 elements, ToFloat32 rounds.
+
+// Returns the vector converted to float64 elements
+func (v Vec2Of[T]) ToFloat64() Vec2d {
+	return Vec2d{float64(v.X), float64(v.Y)}
+}
+
+// Returns the vector converted to float32 elements
+func (v Vec2Of[T]) ToFloat32() Vec2 {
+	return Vec2{float32(v.X), float32(v.Y)}
+}
+
+// Returns the vector converted to float64 elements
+func (v Vec3Of[T]) ToFloat64() Vec3d {
+	return Vec3d{float64(v.X), float64(v.Y), float64(v.Z)}
+}
+
+// Returns the vector converted to float32 elements
+func (v Vec3Of[T]) ToFloat32() Vec3 {
+	return Vec3{float32(v.X), float32(v.Y), float32(v.Z)}
+}
+
+// Returns the vector converted to float64 elements
+func (v Vec4Of[T]) ToFloat64() Vec4d {
+	return Vec4d{float64(v.X), float64(v.Y), float64(v.Z), float64(v.W)}
+}
+
+// Returns the vector converted to float32 elements
+func (v Vec4Of[T]) ToFloat32() Vec4 {
+	return Vec4{float32(v.X), float32(v.Y), float32(v.Z), float32(v.W)}
+}
+
+// Returns the matrix converted to float64 elements
+func (m *Mat3Of[T]) ToFloat64() Mat3d {
+	var r Mat3d
+	for i := range m {
+		r[i] = float64(m[i])
+	}
+	return r
+}
+
+// Returns the matrix converted to float32 elements
+func (m *Mat3Of[T]) ToFloat32() Mat3 {
+	var r Mat3
+	for i := range m {
+		r[i] = float32(m[i])
+	}
+	return r
+}
+
+// Returns the matrix converted to float64 elements
+func (m *Mat4Of[T]) ToFloat64() Mat4d {
+	var r Mat4d
+	for i := range m {
+		r[i] = float64(m[i])
+	}
+	return r
+}
+
+// Returns the matrix converted to float32 elements
+func (m *Mat4Of[T]) ToFloat32() Mat4 {
+	var r Mat4
+	for i := range m {
+		r[i] = float32(m[i])
+	}
+	return r
+}
+
+// Returns the quaternion converted to float64 elements
+func (q QuaternionOf[T]) ToFloat64() Quaterniond {
+	return Quaterniond{float64(q.X), float64(q.Y), float64(q.Z), float64(q.W)}
+}
+
+// Returns the quaternion converted to float32 elements
+func (q QuaternionOf[T]) ToFloat32() Quaternion {
+	return Quaternion{float32(q.X), float32(q.Y), float32(q.Z), float32(q.W)}
+}
+
+// Returns the plane converted to float64 elements
+func (p PlaneOf[T]) ToFloat64() Planed {
+	return Planed{float64(p.A), float64(p.B), float64(p.C), float64(p.D)}
+}
+
+// Returns the plane converted to float32 elements
+func (p PlaneOf[T]) ToFloat32() Plane {
+	return Plane{float32(p.A), float32(p.B), float32(p.C), float32(p.D)}
+}
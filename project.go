@@ -0,0 +1,75 @@
+package mathgl
+
+// Viewport rectangle in window coordinates, as passed to glViewport.
+type Viewport struct {
+	X, Y, Width, Height int
+}
+
+// Maps the Vec3 from object coordinates to window coordinates like
+// gluProject. The resulting Z is the depth in the range 0..1. Returns false
+// if the point can't be projected.
+func (v *Vec3Of[T]) Project(modelview, projection *Mat4Of[T], viewport *Viewport) bool {
+	var t Vec4Of[T]
+	t.Fill(v.X, v.Y, v.Z, 1.0)
+
+	t.Transform(modelview)
+	t.Transform(projection)
+	if t.W == 0.0 {
+		return false
+	}
+
+	t.X /= t.W
+	t.Y /= t.W
+	t.Z /= t.W
+
+	v.X = T(viewport.X) + T(viewport.Width)*(t.X+1.0)/2
+	v.Y = T(viewport.Y) + T(viewport.Height)*(t.Y+1.0)/2
+	v.Z = (t.Z + 1.0) / 2
+	return true
+}
+
+// Maps the Vec3 from window coordinates back to object coordinates like
+// gluUnProject. Z is the depth in the range 0..1, where 0 is the near plane.
+// Returns false if the matrices can't be inverted.
+func (v *Vec3Of[T]) Unproject(modelview, projection *Mat4Of[T], viewport *Viewport) bool {
+	var m Mat4Of[T]
+	m.Assign(projection)
+	m.Multiply(modelview)
+	if !m.Inverse() {
+		return false
+	}
+
+	var t Vec4Of[T]
+	t.X = (v.X-T(viewport.X))/T(viewport.Width)*2.0 - 1.0
+	t.Y = (v.Y-T(viewport.Y))/T(viewport.Height)*2.0 - 1.0
+	t.Z = v.Z*2.0 - 1.0
+	t.W = 1.0
+
+	t.Transform(&m)
+	if t.W == 0.0 {
+		return false
+	}
+
+	v.X = t.X / t.W
+	v.Y = t.Y / t.W
+	v.Z = t.Z / t.W
+	return true
+}
+
+// Returns the ray through the window pixel x, y as an origin on the near plane
+// and a normalized direction. Window coordinates have their origin in the
+// lower left corner like in OpenGL, so mouse coordinates usually need their Y
+// flipped first. Pass the view matrix as modelview to get a ray in world
+// coordinates. Returns false if the matrices can't be inverted.
+func PickRay[T Number](x, y T, modelview, projection *Mat4Of[T], viewport *Viewport) (origin, direction Vec3Of[T], ok bool) {
+	origin.Fill(x, y, 0.0)
+	direction.Fill(x, y, 1.0)
+	if !origin.Unproject(modelview, projection, viewport) ||
+		!direction.Unproject(modelview, projection, viewport) {
+		return origin, direction, false
+	}
+
+	direction.Subtract(&origin)
+	direction.Normalize()
+	return origin, direction, true
+}
@@ -1,10 +1,401 @@
 package mathgl
 
-type Quaternion struct {
-	X, Y, Z, W float32
+import (
+	"fmt"
+	"math"
+)
+
+// Quaternion type. W is the real part, X, Y and Z are the imaginary parts.
+// Rotations need fractions, so integer element types truncate every division,
+// square root and sine, and normalizing a zero integer quaternion panics.
+type QuaternionOf[T Number] struct {
+	X, Y, Z, W T
+}
+
+// Quaternions with float32 and float64 elements.
+type (
+	Quaternion  = QuaternionOf[float32]
+	Quaterniond = QuaternionOf[float64]
+)
+
+// Fills the quaternion with the given values
+func (q *QuaternionOf[T]) Fill(x, y, z, w T) {
+	q.X = x
+	q.Y = y
+	q.Z = z
+	q.W = w
+}
+
+// Sets the quaternion to the identity quaternion, which represents no rotation.
+func (q *QuaternionOf[T]) Identity() {
+	q.X = 0.0
+	q.Y = 0.0
+	q.Z = 0.0
+	q.W = 1.0
+}
+
+// Returns true if the quaternion is the identity quaternion (approximately).
+func (q *QuaternionOf[T]) IsIdentity() bool {
+	var identity QuaternionOf[T]
+	identity.Identity()
+	return q.AreEqual(&identity)
+}
+
+// Returns the length
+func (q *QuaternionOf[T]) Length() T {
+	return sqrtOf(q.LengthSq())
+}
+
+// Returns the length as square
+func (q *QuaternionOf[T]) LengthSq() T {
+	return q.X*q.X + q.Y*q.Y + q.Z*q.Z + q.W*q.W
+}
+
+// Normalize the quaternion
+func (q *QuaternionOf[T]) Normalize() {
+	var l T = 1.0 / q.Length()
+	q.X *= l
+	q.Y *= l
+	q.Z *= l
+	q.W *= l
+}
+
+// Returns the dot product of the two quaternions
+func (q *QuaternionOf[T]) Dot(x *QuaternionOf[T]) T {
+	return q.X*x.X + q.Y*x.Y + q.Z*x.Z + q.W*x.W
+}
+
+// Conjugates the quaternion. For unit quaternions this is the inverse rotation.
+func (q *QuaternionOf[T]) Conjugate() {
+	q.X = -q.X
+	q.Y = -q.Y
+	q.Z = -q.Z
+}
+
+// Inverse the quaternion. Returns false if the quaternion has zero length.
+func (q *QuaternionOf[T]) Inverse() bool {
+	l := q.LengthSq()
+	if l == 0.0 {
+		return false
+	}
+
+	q.Conjugate()
+	q.Scale(1.0 / l)
+	return true
+}
+
+// Adds the given Quaternion to the quaternion
+func (q *QuaternionOf[T]) Add(x *QuaternionOf[T]) {
+	q.X += x.X
+	q.Y += x.Y
+	q.Z += x.Z
+	q.W += x.W
+}
+
+// Scales the quaternion with the given value.
+func (q *QuaternionOf[T]) Scale(s T) {
+	q.X *= s
+	q.Y *= s
+	q.Z *= s
+	q.W *= s
+}
+
+// Negates all components. The quaternion still represents the same rotation.
+func (q *QuaternionOf[T]) negate() {
+	q.X = -q.X
+	q.Y = -q.Y
+	q.Z = -q.Z
+	q.W = -q.W
+}
+
+// Multiplies the quaternion with the given Quaternion. The resulting rotation
+// applies the given quaternion first and then the original one, just like
+// Mat4.Multiply does for matrices.
+func (q *QuaternionOf[T]) Multiply(in *QuaternionOf[T]) {
+	var out QuaternionOf[T]
+
+	out.X = q.W*in.X + q.X*in.W + q.Y*in.Z - q.Z*in.Y
+	out.Y = q.W*in.Y - q.X*in.Z + q.Y*in.W + q.Z*in.X
+	out.Z = q.W*in.Z + q.X*in.Y - q.Y*in.X + q.Z*in.W
+	out.W = q.W*in.W - q.X*in.X - q.Y*in.Y - q.Z*in.Z
+
+	*q = out
+}
+
+// Assigns the given Quaternion to the quaternion
+func (q *QuaternionOf[T]) Assign(x *QuaternionOf[T]) {
+	if q == x {
+		return
+	}
+
+	q.X = x.X
+	q.Y = x.Y
+	q.Z = x.Z
+	q.W = x.W
+}
+
+// Returns true if the quaternions are approximately equal in value
+func (q *QuaternionOf[T]) AreEqual(x *QuaternionOf[T]) bool {
+	return (almostEqual(q.X, x.X) &&
+		almostEqual(q.Y, x.Y) &&
+		almostEqual(q.Z, x.Z) &&
+		almostEqual(q.W, x.W))
+}
+
+// Sets the quaternion to a rotation around the given axis Vec3 by the angle (in radians)
+func (q *QuaternionOf[T]) RotationAxisAngle(axis Vec3Of[T], radians T) {
+	half := radians / 2
+	s := sinOf(half)
+
+	axis.Normalize()
+
+	q.X = axis.X * s
+	q.Y = axis.Y * s
+	q.Z = axis.Z * s
+	q.W = cosOf(half)
+
+	// Fsin32 and Fcos32 are approximations, so make sure we end up with a unit quaternion.
+	q.Normalize()
+}
+
+// Sets the quaternion to the rotation described by the given 3x3 rotation matrix
+func (q *QuaternionOf[T]) RotationMatrix(rotation *Mat3Of[T]) {
+	m := rotation
+	trace := m[0] + m[4] + m[8]
+
+	if trace > 0 {
+		s := 1.0 / (2.0 * sqrtOf(trace+1.0))
+		q.W = 1.0 / (4.0 * s)
+		q.X = (m[5] - m[7]) * s
+		q.Y = (m[6] - m[2]) * s
+		q.Z = (m[1] - m[3]) * s
+	} else if m[0] > m[4] && m[0] > m[8] {
+		s := 2.0 * sqrtOf(1.0+m[0]-m[4]-m[8])
+		q.W = (m[5] - m[7]) / s
+		q.X = s / 4.0
+		q.Y = (m[3] + m[1]) / s
+		q.Z = (m[6] + m[2]) / s
+	} else if m[4] > m[8] {
+		s := 2.0 * sqrtOf(1.0+m[4]-m[0]-m[8])
+		q.W = (m[6] - m[2]) / s
+		q.X = (m[3] + m[1]) / s
+		q.Y = s / 4.0
+		q.Z = (m[7] + m[5]) / s
+	} else {
+		s := 2.0 * sqrtOf(1.0+m[8]-m[0]-m[4])
+		q.W = (m[1] - m[3]) / s
+		q.X = (m[6] + m[2]) / s
+		q.Y = (m[7] + m[5]) / s
+		q.Z = s / 4.0
+	}
+
+	q.Normalize()
+}
+
+// Sets the quaternion to the rotation part of the given 4x4 transformation matrix
+func (q *QuaternionOf[T]) RotationMat4(m *Mat4Of[T]) {
+	q.RotationMatrix(m.ExtractRotation())
+}
+
+// Sets the quaternion to the rotation that turns the negative Z axis into the
+// given forward direction, keeping the Y axis as close to up as possible. This
+// is the camera orientation used by Mat4.LookAt and Mat4.InverseLookAt.
+func (q *QuaternionOf[T]) LookRotation(forward, up *Vec3Of[T]) {
+	s, u, f := lookBasis(forward, up)
+
+	rotation := Mat3Of[T]{s.X, s.Y, s.Z, u.X, u.Y, u.Z, -f.X, -f.Y, -f.Z}
+	q.RotationMatrix(&rotation)
+}
+
+// Returns the rotation of the quaternion as an axis and an angle (in radians).
+// If the quaternion represents no rotation the X axis is returned.
+func (q *QuaternionOf[T]) QuaternionToAxisAngle() (*Vec3Of[T], T) {
+	var axis Vec3Of[T]
+	t := *q
+	if absOf(t.W) > 1.0 {
+		t.Normalize()
+	}
+
+	angle := 2.0 * T(math.Acos(float64(t.W)))
+	s := sqrtOf(1.0 - t.W*t.W)
+
+	if float64(s) < 0.0001 {
+		axis.Fill(1.0, 0.0, 0.0)
+	} else {
+		axis.Fill(t.X/s, t.Y/s, t.Z/s)
+	}
+
+	return &axis, angle
+}
+
+// Sets the quaternion to the natural logarithm of the given unit Quaternion.
+// The result has a zero W component.
+func (q *QuaternionOf[T]) Ln(in *QuaternionOf[T]) {
+	t := *in
+	if t.W > 1.0 {
+		t.W = 1.0
+	} else if one := T(1); t.W < -one {
+		t.W = -one
+	}
+
+	theta := math.Acos(float64(t.W))
+	s := T(math.Sin(theta))
+
+	var f T = 1.0
+	if float64(s) > 0.0001 {
+		f = T(theta) / s
+	}
+
+	q.X = t.X * f
+	q.Y = t.Y * f
+	q.Z = t.Z * f
+	q.W = 0.0
+}
+
+// Sets the quaternion to the exponential of the given Quaternion, whose W
+// component is expected to be zero (as returned by Ln).
+func (q *QuaternionOf[T]) Exp(in *QuaternionOf[T]) {
+	theta := float64(sqrtOf(in.X*in.X + in.Y*in.Y + in.Z*in.Z))
+	s := T(math.Sin(theta))
+
+	var f T = 1.0
+	if theta > 0.0001 {
+		f = s / T(theta)
+	}
+
+	q.X = in.X * f
+	q.Y = in.Y * f
+	q.Z = in.Z * f
+	q.W = T(math.Cos(theta))
+}
+
+// Sets the quaternion to the component wise linear interpolation between a
+// and b. The result is not normalized, use Nlerp if you need a rotation.
+func (q *QuaternionOf[T]) Lerp(a, b *QuaternionOf[T], t T) {
+	q.X = a.X + (b.X-a.X)*t
+	q.Y = a.Y + (b.Y-a.Y)*t
+	q.Z = a.Z + (b.Z-a.Z)*t
+	q.W = a.W + (b.W-a.W)*t
+}
+
+// Sets the quaternion to the normalized linear interpolation between a and b
+// along the shortest path. Cheaper than Slerp, but does not move with constant
+// angular velocity.
+func (q *QuaternionOf[T]) Nlerp(a, b *QuaternionOf[T], t T) {
+	end := *b
+	if a.Dot(b) < 0.0 {
+		end.negate()
+	}
+
+	q.Lerp(a, &end, t)
+	q.Normalize()
+}
+
+// Sets the quaternion to the spherical linear interpolation between a and b
+// along the shortest path.
+func (q *QuaternionOf[T]) Slerp(a, b *QuaternionOf[T], t T) {
+	end := *b
+	if a.Dot(b) < 0.0 {
+		end.negate()
+	}
+	q.slerp(a, &end, t)
+}
+
+// Spherical linear interpolation without choosing the shortest path, as
+// needed by Squad.
+func (q *QuaternionOf[T]) slerp(a, b *QuaternionOf[T], t T) {
+	cosTheta := a.Dot(b)
+
+	// The quaternions are nearly parallel, sin(theta) would be close to zero.
+	// Nlerp would flip b to the shortest path, so lerp without it.
+	if c := float64(cosTheta); c > 0.9995 || c < -0.9995 {
+		q.Lerp(a, b, t)
+		q.Normalize()
+		return
+	}
+
+	theta := math.Acos(float64(cosTheta))
+	sinTheta := math.Sin(theta)
+	wa := T(math.Sin((1.0-float64(t))*theta) / sinTheta)
+	wb := T(math.Sin(float64(t)*theta) / sinTheta)
+
+	q.X = a.X*wa + b.X*wb
+	q.Y = a.Y*wa + b.Y*wb
+	q.Z = a.Z*wa + b.Z*wb
+	q.W = a.W*wa + b.W*wb
+	q.Normalize()
+}
+
+// Sets the quaternion to the spherical quadrangle interpolation between the
+// keys a and b, using the tangents sa and sb computed by SquadTangent.
+func (q *QuaternionOf[T]) Squad(a, b, sa, sb *QuaternionOf[T], t T) {
+	var q1, q2 QuaternionOf[T]
+	q1.slerp(a, b, t)
+	q2.slerp(sa, sb, t)
+	q.slerp(&q1, &q2, 2.0*t*(1.0-t))
+}
+
+// Sets the quaternion to the Squad tangent (inner control point) of the key
+// cur, given its neighbouring keys prev and next.
+func (q *QuaternionOf[T]) SquadTangent(prev, cur, next *QuaternionOf[T]) {
+	p := *prev
+	n := *next
+	if cur.Dot(&p) < 0.0 {
+		p.negate()
+	}
+	if cur.Dot(&n) < 0.0 {
+		n.negate()
+	}
+
+	inv := *cur
+	inv.Conjugate()
+
+	var toPrev, toNext, lnPrev, lnNext QuaternionOf[T]
+	toPrev.Assign(&inv)
+	toPrev.Multiply(&p)
+	toNext.Assign(&inv)
+	toNext.Multiply(&n)
+	lnPrev.Ln(&toPrev)
+	lnNext.Ln(&toNext)
+
+	lnNext.Add(&lnPrev)
+	lnNext.negate()
+	lnNext.Scale(1.0 / T(4))
+
+	var e QuaternionOf[T]
+	e.Exp(&lnNext)
+
+	q.Assign(cur)
+	q.Multiply(&e)
+	q.Normalize()
+}
+
+// Returns the Squad tangents for a sequence of keys. The keys are flipped in
+// place where needed so that neighbouring keys lie in the same hemisphere.
+// The segment between keys[i] and keys[i+1] is then interpolated with
+// Squad(&keys[i], &keys[i+1], &tangents[i], &tangents[i+1], t).
+func SquadTangents[T Number](keys []QuaternionOf[T]) []QuaternionOf[T] {
+	for i := 1; i < len(keys); i++ {
+		if keys[i-1].Dot(&keys[i]) < 0.0 {
+			keys[i].negate()
+		}
+	}
+
+	tangents := make([]QuaternionOf[T], len(keys))
+	for i := range keys {
+		prev := i - 1
+		if prev < 0 {
+			prev = 0
+		}
+		next := i + 1
+		if next >= len(keys) {
+			next = len(keys) - 1
+		}
+		tangents[i].SquadTangent(&keys[prev], &keys[i], &keys[next])
+	}
+	return tangents
 }
 
-func (q *Quaternion) RotationMatrix(rotation *Mat3) {}
-func (q *Quaternion) QuaternionToAxisAngle() (*Vec3, float32) {
-	return nil, 0.0
+func (q *QuaternionOf[T]) String() string {
+	return fmt.Sprintf("Quaternion(%s, %s, %s, %s)", formatOf(q.X), formatOf(q.Y), formatOf(q.Z), formatOf(q.W))
 }
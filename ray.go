@@ -0,0 +1,266 @@
+package mathgl
+
+import "math"
+
+// Ray in 3 dimensions. Hit distances are given in units of the length of
+// Direction, so they are real distances if Direction is normalized.
+type Ray3 struct {
+	Origin, Direction Vec3
+}
+
+// Result of an intersection query with a Ray3.
+type RayHit3 struct {
+	Distance float32
+	Point    Vec3
+	Normal   Vec3
+}
+
+// Oriented box given by its center, its three normalized axes and the half
+// size along each axis.
+type OBB struct {
+	Center  Vec3
+	Axes    [3]Vec3
+	Extents Vec3
+}
+
+// Returns the point at the given distance along the ray.
+func (r *Ray3) PointAt(t float32) Vec3 {
+	v := r.Direction
+	v.Scale(t)
+	v.Add(&r.Origin)
+	return v
+}
+
+// Returns where the ray hits the plane. The normal is the plane normal facing
+// the ray origin.
+func (r *Ray3) IntersectPlane(p *Plane) (RayHit3, bool) {
+	point, t, ok := p.IntersectRay(&r.Origin, &r.Direction)
+	if !ok {
+		return RayHit3{}, false
+	}
+
+	normal := p.Normal()
+	normal.Normalize()
+	if normal.Dot(&r.Direction) > 0.0 {
+		normal.Scale(-1.0)
+	}
+	return RayHit3{t, point, normal}, true
+}
+
+// Returns where the ray first hits the surface of the sphere. If the ray
+// starts inside the sphere the hit is where it leaves the sphere. The normal
+// points away from the sphere center.
+func (r *Ray3) IntersectSphere(s *Sphere) (RayHit3, bool) {
+	var oc Vec3
+	oc.Assign(&r.Origin)
+	oc.Subtract(&s.Center)
+
+	a := r.Direction.LengthSq()
+	b := oc.Dot(&r.Direction)
+	c := oc.LengthSq() - s.Radius*s.Radius
+	discriminant := b*b - a*c
+	if a == 0.0 || discriminant < 0.0 {
+		return RayHit3{}, false
+	}
+
+	root := Fsqrt32(discriminant)
+	t := (-b - root) / a
+	if t < 0.0 {
+		t = (-b + root) / a
+		if t < 0.0 {
+			return RayHit3{}, false
+		}
+	}
+
+	hit := RayHit3{Distance: t, Point: r.PointAt(t)}
+	hit.Normal.Assign(&hit.Point)
+	hit.Normal.Subtract(&s.Center)
+	hit.Normal.Normalize()
+	return hit, true
+}
+
+// Entry and exit distances of a ray passing through a box, with the normals
+// of the faces it passes.
+type slabHit struct {
+	tmin, tmax float32
+	nmin, nmax Vec3
+}
+
+// Clips the hit with the slab along the given axis. e is the distance of the
+// slab center along the axis as seen from the ray origin, f the speed of the
+// ray along the axis and extent the half size of the slab. Returns false if
+// the ray misses the box.
+func (h *slabHit) clip(axis *Vec3, e, f, extent float32) bool {
+	if Fabs32(f) < 1e-6 {
+		// The ray is parallel to the slab
+		return e-extent <= 0.0 && e+extent >= 0.0
+	}
+
+	t1 := (e - extent) / f
+	t2 := (e + extent) / f
+	var n1, n2 Vec3
+	n1.Assign(axis)
+	n1.Scale(-1.0)
+	n2.Assign(axis)
+	if t1 > t2 {
+		t1, t2 = t2, t1
+		n1, n2 = n2, n1
+	}
+
+	if t1 > h.tmin {
+		h.tmin = t1
+		h.nmin = n1
+	}
+	if t2 < h.tmax {
+		h.tmax = t2
+		h.nmax = n2
+	}
+	return h.tmin <= h.tmax
+}
+
+func (r *Ray3) intersectSlabs(center *Vec3, axes *[3]Vec3, extents *Vec3) (RayHit3, bool) {
+	h := slabHit{tmin: -math.MaxFloat32, tmax: math.MaxFloat32}
+	var toCenter Vec3
+	toCenter.Assign(center)
+	toCenter.Subtract(&r.Origin)
+
+	halfSizes := [3]float32{extents.X, extents.Y, extents.Z}
+	for i := range axes {
+		if !h.clip(&axes[i], axes[i].Dot(&toCenter), axes[i].Dot(&r.Direction), halfSizes[i]) {
+			return RayHit3{}, false
+		}
+	}
+
+	if h.tmin >= 0.0 {
+		return RayHit3{h.tmin, r.PointAt(h.tmin), h.nmin}, true
+	}
+	if h.tmax >= 0.0 {
+		return RayHit3{h.tmax, r.PointAt(h.tmax), h.nmax}, true
+	}
+	return RayHit3{}, false
+}
+
+var worldAxes = [3]Vec3{{1.0, 0.0, 0.0}, {0.0, 1.0, 0.0}, {0.0, 0.0, 1.0}}
+
+// Returns where the ray first hits the surface of the box. If the ray starts
+// inside the box the hit is where it leaves the box. The normal points out of
+// the box.
+func (r *Ray3) IntersectAABB(b *AABB3) (RayHit3, bool) {
+	center, extents := b.Center(), b.Extents()
+	return r.intersectSlabs(&center, &worldAxes, &extents)
+}
+
+// Returns where the ray first hits the surface of the oriented box. If the ray
+// starts inside the box the hit is where it leaves the box. The normal points
+// out of the box.
+func (r *Ray3) IntersectOBB(b *OBB) (RayHit3, bool) {
+	return r.intersectSlabs(&b.Center, &b.Axes, &b.Extents)
+}
+
+// Returns where the ray hits the triangle a, b, c using the Möller–Trumbore
+// algorithm. Both sides of the triangle are hit, the normal is the normalized
+// face normal of the counter-clockwise triangle.
+func (r *Ray3) IntersectTriangle(a, b, c *Vec3) (RayHit3, bool) {
+	var e1, e2, p, s, q Vec3
+	e1.Assign(b)
+	e1.Subtract(a)
+	e2.Assign(c)
+	e2.Subtract(a)
+
+	p.Assign(&r.Direction)
+	p.Cross(&e2)
+	det := e1.Dot(&p)
+	if Fabs32(det) < 1e-8 {
+		return RayHit3{}, false
+	}
+	invDet := 1.0 / det
+
+	s.Assign(&r.Origin)
+	s.Subtract(a)
+	u := s.Dot(&p) * invDet
+	if u < 0.0 || u > 1.0 {
+		return RayHit3{}, false
+	}
+
+	q.Assign(&s)
+	q.Cross(&e1)
+	v := r.Direction.Dot(&q) * invDet
+	if v < 0.0 || u+v > 1.0 {
+		return RayHit3{}, false
+	}
+
+	t := e2.Dot(&q) * invDet
+	if t < 0.0 {
+		return RayHit3{}, false
+	}
+
+	hit := RayHit3{Distance: t, Point: r.PointAt(t)}
+	hit.Normal.Assign(&e1)
+	hit.Normal.Cross(&e2)
+	hit.Normal.Normalize()
+	return hit, true
+}
+
+// Ray in 2 dimensions. Hit distances are given in units of the length of
+// Direction, so they are real distances if Direction is normalized.
+type Ray2 struct {
+	Origin, Direction Vec2
+}
+
+// Result of an intersection query with a Ray2.
+type RayHit2 struct {
+	Distance float32
+	Point    Vec2
+	Normal   Vec2
+}
+
+// Returns the point at the given distance along the ray.
+func (r *Ray2) PointAt(t float32) Vec2 {
+	v := r.Direction
+	v.Scale(t)
+	v.Add(&r.Origin)
+	return v
+}
+
+// Returns where the ray hits the segment. The normal is perpendicular to the
+// segment and faces the ray origin. A ray running along the segment doesn't
+// hit it.
+func (r *Ray2) IntersectSeg2(s *Seg2) (RayHit2, bool) {
+	edge := s.Ray()
+	denom := r.Direction.X*edge.Y - r.Direction.Y*edge.X
+	if Fabs32(denom) < 1e-8 {
+		return RayHit2{}, false
+	}
+
+	var toA Vec2
+	toA.Assign(&s.A)
+	toA.Subtract(&r.Origin)
+	t := (toA.X*edge.Y - toA.Y*edge.X) / denom
+	u := (toA.X*r.Direction.Y - toA.Y*r.Direction.X) / denom
+	if t < 0.0 || u < 0.0 || u > 1.0 {
+		return RayHit2{}, false
+	}
+
+	hit := RayHit2{Distance: t, Point: r.PointAt(t)}
+	hit.Normal.Assign(&edge)
+	hit.Normal.Cross()
+	if hit.Normal.Dot(&r.Direction) > 0.0 {
+		hit.Normal.Scale(-1.0)
+	}
+	hit.Normal.Normalize()
+	return hit, true
+}
+
+// Returns where the ray first hits an edge of the polygon.
+func (r *Ray2) IntersectPoly(p Poly) (RayHit2, bool) {
+	var best RayHit2
+	found := false
+	for i := range p {
+		edge := Seg2{p[i], p[(i+1)%len(p)]}
+		if hit, ok := r.IntersectSeg2(&edge); ok && (!found || hit.Distance < best.Distance) {
+			best = hit
+			found = true
+		}
+	}
+	return best, found
+}
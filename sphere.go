@@ -0,0 +1,7 @@
+package mathgl
+
+// Sphere given by its center and radius.
+type Sphere struct {
+	Center Vec3
+	Radius float32
+}
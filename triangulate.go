@@ -0,0 +1,519 @@
+// The ear clipping in this file is a port of earcut by Mapbox,
+// https://github.com/mapbox/earcut, which is released under the ISC license:
+//
+// Copyright (c) 2016, Mapbox
+//
+// Permission to use, copy, modify, and/or distribute this software for any purpose
+// with or without fee is hereby granted, provided that the above copyright notice
+// and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND ISC DISCLAIMS ALL WARRANTIES WITH REGARD TO
+// THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS.
+// IN NO EVENT SHALL ISC BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR
+// CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA
+// OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION,
+// ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package mathgl
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+var ErrTooManyVertices = errors.New("mathgl: too many vertices for 16 bit indices")
+
+// Triangulates the polygon by ear clipping and returns three indices into p
+// per triangle. The triangles are clockwise like the polygon. The polygon may
+// be concave, but must not intersect itself.
+func (p Poly) Triangulate() []uint32 {
+	poly := PolyWithHoles{Outer: p}
+	return poly.Triangulate()
+}
+
+// Same as Triangulate, but returns 16 bit indices for use with
+// GL_UNSIGNED_SHORT element arrays.
+func (p Poly) Triangulate16() ([]uint16, error) {
+	poly := PolyWithHoles{Outer: p}
+	return poly.Triangulate16()
+}
+
+// Returns the vertices of the outer boundary followed by the vertices of all
+// holes, which is the vertex array the indices returned by Triangulate refer
+// to.
+func (p *PolyWithHoles) Vertices() []Vec2 {
+	vertices := append([]Vec2(nil), p.Outer...)
+	for _, hole := range p.Holes {
+		vertices = append(vertices, hole...)
+	}
+	return vertices
+}
+
+// Triangulates the polygon by ear clipping, connecting the holes to the outer
+// boundary with bridge edges first. Returns three indices into Vertices() per
+// triangle. The triangles are clockwise like the outer boundary.
+func (p *PolyWithHoles) Triangulate() []uint32 {
+	var t triangulator
+	t.points = p.Vertices()
+
+	outer := t.ring(0, len(p.Outer), true)
+	if outer == nil || outer.next == outer.prev {
+		return nil
+	}
+
+	// Holes are bridged to the outer boundary from left to right
+	var holes []*earNode
+	start := len(p.Outer)
+	for _, hole := range p.Holes {
+		list := t.ring(start, start+len(hole), false)
+		start += len(hole)
+		if list == nil {
+			continue
+		}
+		holes = append(holes, leftmost(list))
+	}
+	sort.Sort(nodesByX(holes))
+	for _, hole := range holes {
+		outer = t.eliminateHole(hole, outer)
+	}
+
+	t.earcut(outer, 0)
+	return t.triangles
+}
+
+// Same as Triangulate, but returns 16 bit indices for use with
+// GL_UNSIGNED_SHORT element arrays.
+func (p *PolyWithHoles) Triangulate16() ([]uint16, error) {
+	count := len(p.Outer)
+	for _, hole := range p.Holes {
+		count += len(hole)
+	}
+	if count > math.MaxUint16+1 {
+		return nil, ErrTooManyVertices
+	}
+
+	indices := p.Triangulate()
+	result := make([]uint16, len(indices))
+	for i, index := range indices {
+		result[i] = uint16(index)
+	}
+	return result, nil
+}
+
+// Vertex in one of the circular lists the triangulator works on. Bridges
+// between holes and the outer boundary visit vertices twice, so a point can
+// have several nodes.
+type earNode struct {
+	i          uint32
+	x, y       float32
+	prev, next *earNode
+}
+
+// Ear clipping triangulator. Internally the outer boundary is
+// counter-clockwise and the holes are clockwise; the triangles are flipped
+// when they are emitted.
+type triangulator struct {
+	points    []Vec2
+	triangles []uint32
+}
+
+// Builds a circular list of the points from start to end. The outer boundary
+// is made counter-clockwise, holes clockwise.
+func (t *triangulator) ring(start, end int, outer bool) *earNode {
+	if end-start < 3 {
+		return nil
+	}
+	ccw := Poly(t.points[start:end]).SignedArea() > 0
+
+	var last *earNode
+	if ccw == outer {
+		for i := start; i < end; i++ {
+			last = t.insert(i, last)
+		}
+	} else {
+		for i := end - 1; i >= start; i-- {
+			last = t.insert(i, last)
+		}
+	}
+
+	if last != nil && last.equals(last.next) {
+		last.remove()
+		last = last.next
+	}
+	return filterPoints(last, nil)
+}
+
+func (t *triangulator) insert(i int, last *earNode) *earNode {
+	n := &earNode{i: uint32(i), x: t.points[i].X, y: t.points[i].Y}
+	if last == nil {
+		n.prev = n
+		n.next = n
+	} else {
+		n.next = last.next
+		n.prev = last
+		last.next.prev = n
+		last.next = n
+	}
+	return n
+}
+
+func (t *triangulator) emit(a, b, c *earNode) {
+	// Flip the counter-clockwise triangles to the clockwise Poly convention
+	t.triangles = append(t.triangles, a.i, c.i, b.i)
+}
+
+// Clips ears off the list. If no ears are left, the list is cleaned of
+// collinear points, then small self-intersections are cured, and finally it
+// is split in two along a valid diagonal.
+func (t *triangulator) earcut(ear *earNode, pass int) {
+	if ear == nil {
+		return
+	}
+
+	stop := ear
+	for ear.prev != ear.next {
+		prev, next := ear.prev, ear.next
+		if ear.isEar() {
+			t.emit(prev, ear, next)
+			ear.remove()
+			ear = next.next
+			stop = next.next
+			continue
+		}
+
+		ear = next
+		if ear == stop {
+			switch pass {
+			case 0:
+				t.earcut(filterPoints(ear, nil), 1)
+			case 1:
+				ear = t.cureLocalIntersections(filterPoints(ear, nil))
+				t.earcut(ear, 2)
+			case 2:
+				t.splitEarcut(ear)
+			}
+			break
+		}
+	}
+}
+
+func (t *triangulator) cureLocalIntersections(start *earNode) *earNode {
+	p := start
+	for {
+		a, b := p.prev, p.next.next
+		if !a.equals(b) && intersects(a, p, p.next, b) && locallyInside(a, b) && locallyInside(b, a) {
+			t.emit(a, p, b)
+			p.next.remove()
+			p.remove()
+			start = b
+			p = b
+		}
+		p = p.next
+		if p == start {
+			break
+		}
+	}
+	return filterPoints(p, nil)
+}
+
+func (t *triangulator) splitEarcut(start *earNode) {
+	a := start
+	for {
+		for b := a.next.next; b != a.prev; b = b.next {
+			if a.i != b.i && isValidDiagonal(a, b) {
+				c := splitPolygon(a, b)
+				a = filterPoints(a, a.next)
+				c = filterPoints(c, c.next)
+				t.earcut(a, 0)
+				t.earcut(c, 0)
+				return
+			}
+		}
+		a = a.next
+		if a == start {
+			return
+		}
+	}
+}
+
+// Connects the hole to the outer boundary with a bridge edge, so both become
+// one list.
+func (t *triangulator) eliminateHole(hole, outer *earNode) *earNode {
+	bridge := findHoleBridge(hole, outer)
+	if bridge == nil {
+		return outer
+	}
+
+	reverse := splitPolygon(bridge, hole)
+	filterPoints(reverse, reverse.next)
+	return filterPoints(bridge, bridge.next)
+}
+
+// Finds a vertex of the outer boundary which can be connected to the leftmost
+// vertex of the hole (David Eberly's algorithm).
+func findHoleBridge(hole, outer *earNode) *earNode {
+	hx, hy := hole.x, hole.y
+	qx := float32(math.Inf(-1))
+	var m *earNode
+
+	// Find the edge left of the hole hit by a ray going left
+	p := outer
+	for {
+		if hy <= p.y && hy >= p.next.y && p.next.y != p.y {
+			x := p.x + (hy-p.y)*(p.next.x-p.x)/(p.next.y-p.y)
+			if x <= hx && x > qx {
+				qx = x
+				// Take the endpoint further left, the other one may lie
+				// behind the hole
+				m = p
+				if p.next.x < p.x {
+					m = p.next
+				}
+				if x == hx {
+					// The hole touches the edge
+					return m
+				}
+			}
+		}
+		p = p.next
+		if p == outer {
+			break
+		}
+	}
+	if m == nil {
+		return nil
+	}
+
+	// Vertices inside the triangle of the hole point, the hit point and m
+	// would block the bridge; take the one closest in angle to the ray.
+	stop := m
+	mx, my := m.x, m.y
+	tanMin := math.Inf(1)
+	p = m
+	for {
+		ax, cx := qx, hx
+		if hy < my {
+			ax, cx = hx, qx
+		}
+		if hx >= p.x && p.x >= mx && hx != p.x && pointInTriangle(ax, hy, mx, my, cx, hy, p.x, p.y) {
+			tan := math.Abs(float64(hy-p.y)) / float64(hx-p.x)
+			if locallyInside(p, hole) && (tan < tanMin || (tan == tanMin && (p.x > m.x || (p.x == m.x && sectorContainsSector(m, p))))) {
+				m = p
+				tanMin = tan
+			}
+		}
+		p = p.next
+		if p == stop {
+			break
+		}
+	}
+	return m
+}
+
+// Removes duplicate and collinear points from the list between start and end.
+func filterPoints(start, end *earNode) *earNode {
+	if start == nil {
+		return nil
+	}
+	if end == nil {
+		end = start
+	}
+
+	p := start
+	for {
+		again := false
+		if p.equals(p.next) || nodeArea(p.prev, p, p.next) == 0 {
+			p.remove()
+			p = p.prev
+			end = p
+			if p == p.next {
+				break
+			}
+			again = true
+		} else {
+			p = p.next
+		}
+		if !again && p == end {
+			break
+		}
+	}
+	return end
+}
+
+// Splits the list in two by the diagonal from a to b. Returns the node
+// starting the second list.
+func splitPolygon(a, b *earNode) *earNode {
+	a2 := &earNode{i: a.i, x: a.x, y: a.y}
+	b2 := &earNode{i: b.i, x: b.x, y: b.y}
+	an, bp := a.next, b.prev
+
+	a.next = b
+	b.prev = a
+
+	a2.next = an
+	an.prev = a2
+
+	b2.next = a2
+	a2.prev = b2
+
+	bp.next = b2
+	b2.prev = bp
+
+	return b2
+}
+
+func leftmost(start *earNode) *earNode {
+	p, left := start, start
+	for {
+		if p.x < left.x || (p.x == left.x && p.y < left.y) {
+			left = p
+		}
+		p = p.next
+		if p == start {
+			return left
+		}
+	}
+}
+
+type nodesByX []*earNode
+
+func (n nodesByX) Len() int           { return len(n) }
+func (n nodesByX) Less(i, j int) bool { return n[i].x < n[j].x }
+func (n nodesByX) Swap(i, j int)      { n[i], n[j] = n[j], n[i] }
+
+func (n *earNode) remove() {
+	n.next.prev = n.prev
+	n.prev.next = n.next
+}
+
+func (n *earNode) equals(o *earNode) bool {
+	return n.x == o.x && n.y == o.y
+}
+
+// Returns true if the node is a convex vertex with no other vertex inside its
+// triangle.
+func (n *earNode) isEar() bool {
+	a, b, c := n.prev, n, n.next
+	if nodeArea(a, b, c) >= 0 {
+		// Reflex vertex
+		return false
+	}
+
+	for p := c.next; p != a; p = p.next {
+		if !(p.x == a.x && p.y == a.y) && pointInTriangle(a.x, a.y, b.x, b.y, c.x, c.y, p.x, p.y) &&
+			nodeArea(p.prev, p, p.next) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Twice the signed area of the triangle, which is negative for
+// counter-clockwise triangles.
+func nodeArea(p, q, r *earNode) float32 {
+	return (q.y-p.y)*(r.x-q.x) - (q.x-p.x)*(r.y-q.y)
+}
+
+func pointInTriangle(ax, ay, bx, by, cx, cy, px, py float32) bool {
+	return (cx-px)*(ay-py) >= (ax-px)*(cy-py) &&
+		(ax-px)*(by-py) >= (bx-px)*(ay-py) &&
+		(bx-px)*(cy-py) >= (cx-px)*(by-py)
+}
+
+// Returns true if the diagonal from a to b lies inside the polygon near a.
+func locallyInside(a, b *earNode) bool {
+	if nodeArea(a.prev, a, a.next) < 0 {
+		return nodeArea(a, b, a.next) >= 0 && nodeArea(a, a.prev, b) >= 0
+	}
+	return nodeArea(a, b, a.prev) < 0 || nodeArea(a, a.next, b) < 0
+}
+
+// Returns true if the sector of m lies inside the sector of p, used to pick
+// between two nodes of the same point.
+func sectorContainsSector(m, p *earNode) bool {
+	return nodeArea(m.prev, m, p.prev) < 0 && nodeArea(p.next, m, m.next) < 0
+}
+
+// Returns true if the midpoint of the diagonal from a to b lies inside the
+// polygon.
+func middleInside(a, b *earNode) bool {
+	p := a
+	inside := false
+	px, py := (a.x+b.x)/2, (a.y+b.y)/2
+	for {
+		if (p.y > py) != (p.next.y > py) && p.next.y != p.y &&
+			px < (p.next.x-p.x)*(py-p.y)/(p.next.y-p.y)+p.x {
+			inside = !inside
+		}
+		p = p.next
+		if p == a {
+			return inside
+		}
+	}
+}
+
+func isValidDiagonal(a, b *earNode) bool {
+	if a.next.i == b.i || a.prev.i == b.i || intersectsPolygon(a, b) {
+		return false
+	}
+	if locallyInside(a, b) && locallyInside(b, a) && middleInside(a, b) &&
+		(nodeArea(a.prev, a, b.prev) != 0 || nodeArea(a, b.prev, b) != 0) {
+		return true
+	}
+	return a.equals(b) && nodeArea(a.prev, a, a.next) > 0 && nodeArea(b.prev, b, b.next) > 0
+}
+
+// Returns true if the diagonal from a to b crosses an edge of the polygon.
+func intersectsPolygon(a, b *earNode) bool {
+	p := a
+	for {
+		if p.i != a.i && p.next.i != a.i && p.i != b.i && p.next.i != b.i && intersects(p, p.next, a, b) {
+			return true
+		}
+		p = p.next
+		if p == a {
+			return false
+		}
+	}
+}
+
+func signum32(v float32) int {
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	}
+	return 0
+}
+
+// Returns true if q lies on the segment from p to r, given they are collinear.
+func onSegment(p, q, r *earNode) bool {
+	return q.x <= Fmax32(p.x, r.x) && q.x >= Fmin32(p.x, r.x) && q.y <= Fmax32(p.y, r.y) && q.y >= Fmin32(p.y, r.y)
+}
+
+// Returns true if the segments p1-q1 and p2-q2 intersect.
+func intersects(p1, q1, p2, q2 *earNode) bool {
+	o1 := signum32(nodeArea(p1, q1, p2))
+	o2 := signum32(nodeArea(p1, q1, q2))
+	o3 := signum32(nodeArea(p2, q2, p1))
+	o4 := signum32(nodeArea(p2, q2, q1))
+
+	if o1 != o2 && o3 != o4 {
+		return true
+	}
+	if o1 == 0 && onSegment(p1, p2, q1) {
+		return true
+	}
+	if o2 == 0 && onSegment(p1, q2, q1) {
+		return true
+	}
+	if o3 == 0 && onSegment(p2, p1, q2) {
+		return true
+	}
+	if o4 == 0 && onSegment(p2, q1, q2) {
+		return true
+	}
+	return false
+}
@@ -1,13 +1,18 @@
 package mathgl
 
-import (
-  "fmt"
-)
-
-// Polys need to be defined in clock-wise order
+// Polys need to be defined in clock-wise order, Validate checks for that
 type Poly []Vec2
 
-func (p *Poly) Clip(s *Seg2) {
+// Clips away the part of the polygon on the left side of s.  The polygon must
+// be clockwise and convex, otherwise it is left unchanged and the error from
+// Validate or ErrPolyNotConvex is returned.
+func (p *Poly) Clip(s *Seg2) error {
+  if err := p.Validate(); err != nil {
+    return err
+  }
+  if !p.IsConvex() {
+    return ErrPolyNotConvex
+  }
   var start int
   for start = 0; start < len(*p); start++ {
     if s.Right(&(*p)[start]) {
@@ -16,7 +21,7 @@ func (p *Poly) Clip(s *Seg2) {
   }
   if start == len(*p) {
     *p = (*p)[0:0]
-    return
+    return nil
   }
   clip1, clip2 := -1, -1
   var isect1, isect2 Vec2
@@ -39,9 +44,8 @@ func (p *Poly) Clip(s *Seg2) {
     }
   }
   if clip2 == -1 {
-    return
+    return nil
   }
-  fmt.Printf("Isect at %d: %v, %d: %v\n", clip1, isect1, clip2, isect2)
   var clipper Poly
   clipper = append(clipper, isect1)
   clipper = append(clipper, isect2)
@@ -51,6 +55,12 @@ func (p *Poly) Clip(s *Seg2) {
     clipper = append(clipper, (*p)[i])
   }
   *p = clipper
+  return nil
+}
+
+// Returns the smallest axis aligned box containing the polygon
+func (p Poly) Bounds() AABB2 {
+  return AABB2FromPoints(p)
 }
 
 
@@ -60,10 +70,7 @@ type Seg2 struct {
 }
 
 func (a Seg2) Ray() Vec2 {
-  var v Vec2
-  v.Assign(&a.B)
-  v.Subtract(&a.A)
-  return v
+  return a.B.Minus(a.A)
 }
 
 // Returns a Vec2 indicating the intersection point of the lines passing
@@ -76,6 +83,121 @@ func (u Seg2) Isect(v *Seg2) Vec2 {
   f := n/d
   return Vec2{ u.A.X + (u.B.X - u.A.X) * f, u.A.Y + (u.B.Y - u.A.Y) * f}
 }
+// Returns the point at parameter t along the segment, t = 0 being A and t = 1
+// being B
+func (a Seg2) At(t float32) Vec2 {
+  v := a.Ray()
+  v.Scale(t)
+  v.Add(&a.A)
+  return v
+}
+
+type SegIsectEnum int
+
+const (
+  SEGMENTS_DISJOINT SegIsectEnum = iota
+  SEGMENTS_INTERSECT
+  SEGMENTS_OVERLAP
+)
+
+// Result of intersecting two segments u and v.  S and T are the parameters of
+// the intersection point along u and v.  If the segments are collinear and
+// overlap, S..S1 is the overlapping part of u and T..T1 the same part of v.
+type Seg2Isect struct {
+  Kind   SegIsectEnum
+  S, T   float32
+  S1, T1 float32
+}
+
+func cross2(a, b *Vec2) float32 {
+  return a.X*b.Y - a.Y*b.X
+}
+
+// Returns the parameter along a of the point on a's line closest to v
+func (a Seg2) project(v *Vec2) float32 {
+  r := a.Ray()
+  var d Vec2
+  d.Assign(v)
+  d.Subtract(&a.A)
+  l := r.LengthSq()
+  if l == 0 {
+    return 0
+  }
+  return d.Dot(&r) / l
+}
+
+// Intersects the segments u and v, unlike Isect this only reports points that
+// lie on both segments, endpoints included.  Parallel segments never
+// intersect unless they are collinear and overlap.
+func (u Seg2) SegIsect(v *Seg2) Seg2Isect {
+  const tolerance = 1e-6
+  r := u.Ray()
+  s := v.Ray()
+  var qp Vec2
+  qp.Assign(&v.A)
+  qp.Subtract(&u.A)
+
+  denom := cross2(&r, &s)
+  rs := Fsqrt32(r.LengthSq() * s.LengthSq())
+  if Fabs32(denom) > tolerance*rs {
+    su := cross2(&qp, &s) / denom
+    tv := cross2(&qp, &r) / denom
+    if su < 0 || su > 1 || tv < 0 || tv > 1 {
+      return Seg2Isect{Kind: SEGMENTS_DISJOINT}
+    }
+    return Seg2Isect{SEGMENTS_INTERSECT, su, tv, su, tv}
+  }
+
+  // Parallel or degenerate, the segments can only meet if they are collinear
+  if r.LengthSq() == 0 {
+    tv := v.project(&u.A)
+    if tv < 0 || tv > 1 || v.DistFromPoint(&u.A) > tolerance*Fsqrt32(s.LengthSq()) {
+      return Seg2Isect{Kind: SEGMENTS_DISJOINT}
+    }
+    return Seg2Isect{SEGMENTS_INTERSECT, 0, tv, 0, tv}
+  }
+  if Fabs32(cross2(&qp, &r)) > tolerance*Fsqrt32(qp.LengthSq()*r.LengthSq()) {
+    return Seg2Isect{Kind: SEGMENTS_DISJOINT}
+  }
+
+  s0 := u.project(&v.A)
+  s1 := u.project(&v.B)
+  if s0 > s1 {
+    s0, s1 = s1, s0
+  }
+  s0 = Fmax32(s0, 0)
+  s1 = Fmin32(s1, 1)
+  if s0 > s1 {
+    return Seg2Isect{Kind: SEGMENTS_DISJOINT}
+  }
+  p0 := u.At(s0)
+  p1 := u.At(s1)
+  t0 := v.project(&p0)
+  t1 := v.project(&p1)
+  if s0 == s1 {
+    return Seg2Isect{SEGMENTS_INTERSECT, s0, t0, s0, t0}
+  }
+  return Seg2Isect{SEGMENTS_OVERLAP, s0, t0, s1, t1}
+}
+
+// Returns the point on the segment closest to v
+func (a Seg2) ClosestPoint(v *Vec2) Vec2 {
+  t := a.project(v)
+  if t < 0 {
+    t = 0
+  } else if t > 1 {
+    t = 1
+  }
+  return a.At(t)
+}
+
+// Returns the distance of v from the segment
+func (a Seg2) DistFromPoint(v *Vec2) float32 {
+  p := a.ClosestPoint(v)
+  p.Subtract(v)
+  return p.Length()
+}
+
 func (a Seg2) DistFromOrigin() float32 {
   a_ray := a.Ray()
   a_ray.Cross()
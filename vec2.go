@@ -1,59 +1,77 @@
 package mathgl
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+)
+
+//go:generate go run gen_swizzle.go
 
 // 2 dimensional vector.
-type Vec2 struct {
-	X, Y float32
+type Vec2Of[T Number] struct {
+	X, Y T
 }
 
-// Fills the vector with the given float32
-func (v *Vec2) Fill(x, y float32) {
+// Vectors with float32, float64 and int32 elements.
+type (
+	Vec2  = Vec2Of[float32]
+	Vec2d = Vec2Of[float64]
+	Vec2i = Vec2Of[int32]
+)
+
+// Fills the vector with the given values
+func (v *Vec2Of[T]) Fill(x, y T) {
 	v.X = x
 	v.Y = y
 }
 
-// Returns the length  as float32
-func (v *Vec2) Length() float32 {
-	return Fsqrt32(Fsqr32(v.X) + Fsqr32(v.Y))
+// Returns the length
+// Integer lengths are truncated.
+func (v *Vec2Of[T]) Length() T {
+	return sqrtOf(v.X*v.X + v.Y*v.Y)
 }
 
-// Returns the length as square as float32
-func (v *Vec2) LengthSq() float32 {
-	return Fsqr32(v.X) + Fsqr32(v.Y)
+// Returns the length as square
+func (v *Vec2Of[T]) LengthSq() T {
+	return v.X*v.X + v.Y*v.Y
 }
 
-// Normalize the vector
-func (v *Vec2) Normalize() {
-	var l float32 = 1.0 / v.Length()
+// Normalize the vector. A zero vector becomes NaN, see SafeNormalize
+// Integer vectors are normalized like SafeNormalized.
+func (v *Vec2Of[T]) Normalize() {
+	if !isFloat[T]() {
+		*v = v.SafeNormalized()
+		return
+	}
+	var l T = 1.0 / v.Length()
 	v.X *= l
 	v.Y *= l
 }
 
-func (v *Vec2) Cross() {
+func (v *Vec2Of[T]) Cross() {
 	v.X, v.Y = -v.Y, v.X
 }
 
 // Adds the given Vec2 with the vector
-func (v *Vec2) Add(x *Vec2) {
+func (v *Vec2Of[T]) Add(x *Vec2Of[T]) {
 	v.X += x.X
 	v.Y += x.Y
 }
 
-// Returns the cosine of the angle between the vectors as float32
-func (v *Vec2) Dot(x *Vec2) float32 {
+// Returns the cosine of the angle between the vectors
+func (v *Vec2Of[T]) Dot(x *Vec2Of[T]) T {
 	return v.X*x.X + v.Y*x.Y
 }
 
 // Subtracts the given Vec2 from the vector
-func (v *Vec2) Subtract(x *Vec2) {
+func (v *Vec2Of[T]) Subtract(x *Vec2Of[T]) {
 	v.X -= x.X
 	v.Y -= x.Y
 }
 
 // Transforms the Vec2 by a given Mat3
-func (v *Vec2) Transform(m *Mat3) {
-	var t Vec2
+func (v *Vec2Of[T]) Transform(m *Mat3Of[T]) {
+	var t Vec2Of[T]
 	t.X = v.X
 	t.Y = v.Y
 
@@ -61,14 +79,14 @@ func (v *Vec2) Transform(m *Mat3) {
 	v.Y = t.X*m[1] + t.Y*m[4] + m[7]
 }
 
-// Scales the vector with the given float32.
-func (v *Vec2) Scale(s float32) {
+// Scales the vector with the given value.
+func (v *Vec2Of[T]) Scale(s T) {
 	v.X *= s
 	v.Y *= s
 }
 
 // Assigns the given Vec2 to the Vec2
-func (v *Vec2) Assign(x *Vec2) {
+func (v *Vec2Of[T]) Assign(x *Vec2Of[T]) {
 	if v == x {
 		return
 	}
@@ -78,17 +96,180 @@ func (v *Vec2) Assign(x *Vec2) {
 }
 
 // Returns true if the vectors are approximately equal in value
-func (v *Vec2) AreEqual(x *Vec2) bool {
-	return ((v.X < x.X+epsilon && v.X > x.X-epsilon) &&
-		(v.Y < x.Y+epsilon && v.Y > x.Y-epsilon))
+func (v *Vec2Of[T]) AreEqual(x *Vec2Of[T]) bool {
+	return (almostEqual(v.X, x.X) &&
+		almostEqual(v.Y, x.Y))
 }
 
 // Sets all the elements of Vec2 to zero.
-func (v *Vec2) Zero() {
+func (v *Vec2Of[T]) Zero() {
 	v.X = 0.0
 	v.Y = 0.0
 }
 
-func (v *Vec2) String() string {
-	return fmt.Sprintf("Vec2(%f, %f)", v.X, v.Y)
+// Returns the sum of the vectors, leaving both unchanged
+func (v Vec2Of[T]) Plus(x Vec2Of[T]) Vec2Of[T] {
+	return Vec2Of[T]{v.X + x.X, v.Y + x.Y}
+}
+
+// Returns the difference of the vectors, leaving both unchanged
+func (v Vec2Of[T]) Minus(x Vec2Of[T]) Vec2Of[T] {
+	return Vec2Of[T]{v.X - x.X, v.Y - x.Y}
+}
+
+// Returns the vector scaled by s
+func (v Vec2Of[T]) Times(s T) Vec2Of[T] {
+	return Vec2Of[T]{v.X * s, v.Y * s}
+}
+
+// Returns the vector rotated by 90 degrees counter-clockwise, like Cross
+func (v Vec2Of[T]) Crossed() Vec2Of[T] {
+	return Vec2Of[T]{-v.Y, v.X}
+}
+
+// Returns the vector scaled to length 1
+// Integer vectors are normalized like SafeNormalized.
+func (v Vec2Of[T]) Normalized() Vec2Of[T] {
+	if !isFloat[T]() {
+		return v.SafeNormalized()
+	}
+	// math.Sqrt is a compiler intrinsic, unlike Fsqrt32 it still allows
+	// inlining
+	l := T(1 / math.Sqrt(float64(v.X*v.X+v.Y*v.Y)))
+	return Vec2Of[T]{v.X * l, v.Y * l}
+}
+
+// Returns the vector transformed by the given Mat3
+func (v Vec2Of[T]) Transformed(m *Mat3Of[T]) Vec2Of[T] {
+	return Vec2Of[T]{v.X*m[0] + v.Y*m[3] + m[6], v.X*m[1] + v.Y*m[4] + m[7]}
+}
+
+// Returns the distance between the points
+// Integer distances are truncated.
+func (v Vec2Of[T]) Distance(x Vec2Of[T]) T {
+	return T(math.Sqrt(float64(v.DistanceSq(x))))
+}
+
+// Returns the squared distance between the points, which is cheaper to
+// compute than Distance
+func (v Vec2Of[T]) DistanceSq(x Vec2Of[T]) T {
+	d := v.Minus(x)
+	return d.X*d.X + d.Y*d.Y
+}
+
+// Returns the linear interpolation between v at t=0 and x at t=1
+// Integer vectors take a whole t, so they only reach v, x and their
+// extrapolations.
+func (v Vec2Of[T]) Lerp(x Vec2Of[T], t T) Vec2Of[T] {
+	return Vec2Of[T]{v.X + (x.X-v.X)*t, v.Y + (x.Y-v.Y)*t}
+}
+
+// Returns the angle between the vectors in radians, from 0 to Pi. It is 0 if
+// either vector is zero.
+// Integer vectors get the angle truncated to whole radians.
+func (v Vec2Of[T]) Angle(x Vec2Of[T]) T {
+	return T(math.Atan2(math.Abs(float64(v.X*x.Y-v.Y*x.X)), float64(v.Dot(&x))))
+}
+
+// Returns the part of v parallel to x. It is zero if x is zero.
+// Integer vectors truncate the scale factor towards zero.
+func (v Vec2Of[T]) ProjectedOnto(x Vec2Of[T]) Vec2Of[T] {
+	l := x.Dot(&x)
+	if l == 0 {
+		return Vec2Of[T]{}
+	}
+	return x.Times(v.Dot(&x) / l)
+}
+
+// Returns the part of v perpendicular to x, so that v is the sum of
+// ProjectedOnto(x) and RejectedFrom(x)
+func (v Vec2Of[T]) RejectedFrom(x Vec2Of[T]) Vec2Of[T] {
+	return v.Minus(v.ProjectedOnto(x))
+}
+
+// Returns the direction v reflected at a surface with the given unit normal,
+// like reflect in GLSL
+func (v Vec2Of[T]) Reflected(normal Vec2Of[T]) Vec2Of[T] {
+	return v.Minus(normal.Times(2 * normal.Dot(&v)))
+}
+
+// Returns the unit direction v refracted at a surface with the given unit
+// normal, where eta is the ratio of the refraction indices, like refract in
+// GLSL. It is zero on total internal reflection.
+// Integer vectors take a whole eta and truncate the square root.
+func (v Vec2Of[T]) Refracted(normal Vec2Of[T], eta T) Vec2Of[T] {
+	d := normal.Dot(&v)
+	k := 1 - eta*eta*(1-d*d)
+	if k < 0 {
+		return Vec2Of[T]{}
+	}
+	return v.Times(eta).Minus(normal.Times(eta*d + T(math.Sqrt(float64(k)))))
+}
+
+// Returns the component-wise minimum of the vectors
+func (v Vec2Of[T]) Min(x Vec2Of[T]) Vec2Of[T] {
+	return Vec2Of[T]{minOf(v.X, x.X), minOf(v.Y, x.Y)}
+}
+
+// Returns the component-wise maximum of the vectors
+func (v Vec2Of[T]) Max(x Vec2Of[T]) Vec2Of[T] {
+	return Vec2Of[T]{maxOf(v.X, x.X), maxOf(v.Y, x.Y)}
+}
+
+// Returns v with each component clamped to the range given by min and max
+func (v Vec2Of[T]) Clamp(min, max Vec2Of[T]) Vec2Of[T] {
+	return v.Max(min).Min(max)
+}
+
+// Returns the component-wise absolute value
+func (v Vec2Of[T]) Abs() Vec2Of[T] {
+	return Vec2Of[T]{absOf(v.X), absOf(v.Y)}
+}
+
+// Returns the component-wise product of the vectors
+func (v Vec2Of[T]) Mul(x Vec2Of[T]) Vec2Of[T] {
+	return Vec2Of[T]{v.X * x.X, v.Y * x.Y}
+}
+
+// Returns the component-wise quotient of the vectors. Float components
+// divided by zero become infinite or NaN, integer quotients are truncated and
+// dividing an integer by zero panics.
+func (v Vec2Of[T]) Div(x Vec2Of[T]) Vec2Of[T] {
+	return Vec2Of[T]{v.X / x.X, v.Y / x.Y}
+}
+
+// Returns the vector scaled to length 1, or the zero vector if v is zero
+// where Normalized would give NaN
+// Integer components are truncated towards zero, so only vectors along an
+// axis keep a nonzero component.
+func (v Vec2Of[T]) SafeNormalized() Vec2Of[T] {
+	l := math.Sqrt(float64(v.X)*float64(v.X) + float64(v.Y)*float64(v.Y))
+	if l == 0 {
+		return Vec2Of[T]{}
+	}
+	return Vec2Of[T]{T(float64(v.X) / l), T(float64(v.Y) / l)}
+}
+
+// Normalizes the vector unless it is zero. Returns false if it is.
+func (v *Vec2Of[T]) SafeNormalize() bool {
+	n := v.SafeNormalized()
+	if n == (Vec2Of[T]{}) {
+		return false
+	}
+	*v = n
+	return true
+}
+
+// Returns the vector extended by the given z
+func (v Vec2Of[T]) Vec3(z T) Vec3Of[T] {
+	return Vec3Of[T]{v.X, v.Y, z}
+}
+
+// Returns the vector extended by the given z and w
+func (v Vec2Of[T]) Vec4(z, w T) Vec4Of[T] {
+	return Vec4Of[T]{v.X, v.Y, z, w}
+}
+
+func (v *Vec2Of[T]) String() string {
+	return fmt.Sprintf("Vec2(%s, %s)", formatOf(v.X), formatOf(v.Y))
 }
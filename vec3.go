@@ -1,52 +1,68 @@
 package mathgl
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+)
 
-// 2 dimensional vector.
-type Vec3 struct {
-	X, Y, Z float32
+// 3 dimensional vector.
+type Vec3Of[T Number] struct {
+	X, Y, Z T
 }
 
-// Fills the vector with the given float32
-func (v *Vec3) Fill(x, y, z float32) {
+// Vectors with float32, float64 and int32 elements.
+type (
+	Vec3  = Vec3Of[float32]
+	Vec3d = Vec3Of[float64]
+	Vec3i = Vec3Of[int32]
+)
+
+// Fills the vector with the given values
+func (v *Vec3Of[T]) Fill(x, y, z T) {
 	v.X = x
 	v.Y = y
 	v.Z = z
 }
 
-// Returns the length  as float32
-func (v *Vec3) Length() float32 {
-	return Fsqrt32(Fsqr32(v.X) + Fsqr32(v.Y) + Fsqr32(v.Z))
+// Returns the length
+// Integer lengths are truncated.
+func (v *Vec3Of[T]) Length() T {
+	return sqrtOf(v.X*v.X + v.Y*v.Y + v.Z*v.Z)
 }
 
-// Returns the length as square as float32
-func (v *Vec3) LengthSq() float32 {
-	return Fsqr32(v.X) + Fsqr32(v.Y) + Fsqr32(v.Z)
+// Returns the length as square
+func (v *Vec3Of[T]) LengthSq() T {
+	return v.X*v.X + v.Y*v.Y + v.Z*v.Z
 }
 
-// Normalize the vector
-func (v *Vec3) Normalize() {
-	var l float32 = 1.0 / v.Length()
+// Normalize the vector. A zero vector becomes NaN, see SafeNormalize
+// Integer vectors are normalized like SafeNormalized.
+func (v *Vec3Of[T]) Normalize() {
+	if !isFloat[T]() {
+		*v = v.SafeNormalized()
+		return
+	}
+	var l T = 1.0 / v.Length()
 	v.X *= l
 	v.Y *= l
 	v.Z *= l
 }
 
 // Adds the given Vec3 with the vector
-func (v *Vec3) Add(x *Vec3) {
+func (v *Vec3Of[T]) Add(x *Vec3Of[T]) {
 	v.X += x.X
 	v.Y += x.Y
 	v.Z += x.Z
 }
 
-// Returns the cosine of the angle between the vectors as float32
-func (v *Vec3) Dot(x *Vec3) float32 {
+// Returns the cosine of the angle between the vectors
+func (v *Vec3Of[T]) Dot(x *Vec3Of[T]) T {
 	return v.X*x.X + v.Y*x.Y + v.Z*x.Z
 }
 
 // Saves the Vec3 perpendicular to the given Vec3
-func (v *Vec3) Cross(x *Vec3) {
-	var t Vec3
+func (v *Vec3Of[T]) Cross(x *Vec3Of[T]) {
+	var t Vec3Of[T]
 	t.X = v.X
 	t.Y = v.Y
 	t.Z = v.Z
@@ -57,15 +73,15 @@ func (v *Vec3) Cross(x *Vec3) {
 }
 
 // Subtracts the given Vec3 from the vector
-func (v *Vec3) Subtract(x *Vec3) {
+func (v *Vec3Of[T]) Subtract(x *Vec3Of[T]) {
 	v.X -= x.X
 	v.Y -= x.Y
 	v.Z -= x.Z
 }
 
 // Transforms the Vec3 by a given Mat4
-func (v *Vec3) Transform(m *Mat4) {
-	var t Vec3
+func (v *Vec3Of[T]) Transform(m *Mat4Of[T]) {
+	var t Vec3Of[T]
 	t.X = v.X
 	t.Y = v.Y
 	t.Z = v.Z
@@ -76,8 +92,8 @@ func (v *Vec3) Transform(m *Mat4) {
 }
 
 // Transforms the Vec3 by a given Mat4 inversely
-func (v *Vec3) InverseTransform(m *Mat4) {
-	var t Vec3
+func (v *Vec3Of[T]) InverseTransform(m *Mat4Of[T]) {
+	var t Vec3Of[T]
 	t.X = v.X - m[12]
 	t.Y = v.Y - m[13]
 	t.Z = v.Z - m[14]
@@ -88,20 +104,16 @@ func (v *Vec3) InverseTransform(m *Mat4) {
 }
 
 // Transform a texture Vec3 with the given Mat4 matrix
-func (v *Vec3) TransformCoord(m *Mat4) {
-	var t Vec4
-	t.Fill(v.X, v.Y, v.Z, 1.0)
-
+// Integer vectors truncate the division by W and panic if W is zero.
+func (v *Vec3Of[T]) TransformCoord(m *Mat4Of[T]) {
+	t := v.Vec4(1)
 	t.Transform(m)
-
-	v.X = t.X / t.W
-	v.Y = t.Y / t.W
-	v.Z = t.Z / t.W
+	*v = t.PerspectiveDivide()
 }
 
 // Transform a normal Vec3 with the given Mat4 matrix. Omits the translation, only scaling + rotating
-func (v *Vec3) TransformNormal(m *Mat4) {
-	var t Vec3
+func (v *Vec3Of[T]) TransformNormal(m *Mat4Of[T]) {
+	var t Vec3Of[T]
 	t.X = v.X
 	t.Y = v.Y
 	t.Z = v.Z
@@ -112,8 +124,8 @@ func (v *Vec3) TransformNormal(m *Mat4) {
 }
 
 // Transforms a normal Vec3 with the given Mat4 matrix inversely. Omits the translation, only scaling + rotating
-func (v *Vec3) InverseTransformNormal(m *Mat4) {
-	var t Vec3
+func (v *Vec3Of[T]) InverseTransformNormal(m *Mat4Of[T]) {
+	var t Vec3Of[T]
 	t.X = v.X
 	t.Y = v.Y
 	t.Z = v.Z
@@ -123,22 +135,40 @@ func (v *Vec3) InverseTransformNormal(m *Mat4) {
 	v.Z = t.X*m[8] + t.Y*m[9] + t.Z*m[10]
 }
 
-// Scales a vector to the given length s in float32.
-func (v *Vec3) Scale(s float32) {
+// Rotates the Vec3 with the given unit Quaternion
+func (v *Vec3Of[T]) Rotate(q *QuaternionOf[T]) {
+	// v' = v + 2w(u x v) + 2u x (u x v), where u is the vector part of q
+	var u, t, c Vec3Of[T]
+	u.Fill(q.X, q.Y, q.Z)
+
+	t.Assign(&u)
+	t.Cross(v)
+	t.Scale(2.0)
+
+	c.Assign(&u)
+	c.Cross(&t)
+
+	t.Scale(q.W)
+	v.Add(&t)
+	v.Add(&c)
+}
+
+// Scales a vector to the given length s.
+func (v *Vec3Of[T]) Scale(s T) {
 	v.X *= s
 	v.Y *= s
 	v.Z *= s
 }
 
 // Returns true if the vectors are approximately equal in value
-func (v *Vec3) AreEqual(x *Vec3) bool {
-	return ((v.X < x.X+epsilon && v.X > x.X-epsilon) &&
-		(v.Y < x.Y+epsilon && v.Y > x.Y-epsilon) &&
-		(v.Z < x.Z+epsilon && v.Z > x.Z-epsilon))
+func (v *Vec3Of[T]) AreEqual(x *Vec3Of[T]) bool {
+	return (almostEqual(v.X, x.X) &&
+		almostEqual(v.Y, x.Y) &&
+		almostEqual(v.Z, x.Z))
 }
 
 // Assigns the given Vec3 to the Vec3
-func (v *Vec3) Assign(x *Vec3) {
+func (v *Vec3Of[T]) Assign(x *Vec3Of[T]) {
 	if v == x {
 		return
 	}
@@ -149,12 +179,174 @@ func (v *Vec3) Assign(x *Vec3) {
 }
 
 // Sets all the elements of Vec3 to zero
-func (v *Vec3) Zero() {
+func (v *Vec3Of[T]) Zero() {
 	v.X = 0.0
 	v.Y = 0.0
 	v.Z = 0.0
 }
 
-func (v *Vec3) String() string {
-	return fmt.Sprintf("Vec3(%f, %f, %f)", v.X, v.Y, v.Z)
+// Returns the sum of the vectors, leaving both unchanged
+func (v Vec3Of[T]) Plus(x Vec3Of[T]) Vec3Of[T] {
+	return Vec3Of[T]{v.X + x.X, v.Y + x.Y, v.Z + x.Z}
+}
+
+// Returns the difference of the vectors, leaving both unchanged
+func (v Vec3Of[T]) Minus(x Vec3Of[T]) Vec3Of[T] {
+	return Vec3Of[T]{v.X - x.X, v.Y - x.Y, v.Z - x.Z}
+}
+
+// Returns the vector scaled by s
+func (v Vec3Of[T]) Times(s T) Vec3Of[T] {
+	return Vec3Of[T]{v.X * s, v.Y * s, v.Z * s}
+}
+
+// Returns the cross product of the vectors
+func (v Vec3Of[T]) Crossed(x Vec3Of[T]) Vec3Of[T] {
+	return Vec3Of[T]{v.Y*x.Z - v.Z*x.Y, v.Z*x.X - v.X*x.Z, v.X*x.Y - v.Y*x.X}
+}
+
+// Returns the vector scaled to length 1
+// Integer vectors are normalized like SafeNormalized.
+func (v Vec3Of[T]) Normalized() Vec3Of[T] {
+	if !isFloat[T]() {
+		return v.SafeNormalized()
+	}
+	l := T(1 / math.Sqrt(float64(v.X*v.X+v.Y*v.Y+v.Z*v.Z)))
+	return Vec3Of[T]{v.X * l, v.Y * l, v.Z * l}
+}
+
+// Returns the vector transformed by the given Mat4
+func (v Vec3Of[T]) Transformed(m *Mat4Of[T]) Vec3Of[T] {
+	return Vec3Of[T]{
+		v.X*m[0] + v.Y*m[4] + v.Z*m[8] + m[12],
+		v.X*m[1] + v.Y*m[5] + v.Z*m[9] + m[13],
+		v.X*m[2] + v.Y*m[6] + v.Z*m[10] + m[14],
+	}
+}
+
+// Returns the distance between the points
+// Integer distances are truncated.
+func (v Vec3Of[T]) Distance(x Vec3Of[T]) T {
+	return T(math.Sqrt(float64(v.DistanceSq(x))))
+}
+
+// Returns the squared distance between the points, which is cheaper to
+// compute than Distance
+func (v Vec3Of[T]) DistanceSq(x Vec3Of[T]) T {
+	d := v.Minus(x)
+	return d.X*d.X + d.Y*d.Y + d.Z*d.Z
+}
+
+// Returns the linear interpolation between v at t=0 and x at t=1
+// Integer vectors take a whole t, so they only reach v, x and their
+// extrapolations.
+func (v Vec3Of[T]) Lerp(x Vec3Of[T], t T) Vec3Of[T] {
+	return Vec3Of[T]{v.X + (x.X-v.X)*t, v.Y + (x.Y-v.Y)*t, v.Z + (x.Z-v.Z)*t}
+}
+
+// Returns the angle between the vectors in radians, from 0 to Pi. It is 0 if
+// either vector is zero.
+// Integer vectors get the angle truncated to whole radians.
+func (v Vec3Of[T]) Angle(x Vec3Of[T]) T {
+	c := v.Crossed(x)
+	return T(math.Atan2(float64(c.Length()), float64(v.Dot(&x))))
+}
+
+// Returns the part of v parallel to x. It is zero if x is zero.
+// Integer vectors truncate the scale factor towards zero.
+func (v Vec3Of[T]) ProjectedOnto(x Vec3Of[T]) Vec3Of[T] {
+	l := x.Dot(&x)
+	if l == 0 {
+		return Vec3Of[T]{}
+	}
+	return x.Times(v.Dot(&x) / l)
+}
+
+// Returns the part of v perpendicular to x, so that v is the sum of
+// ProjectedOnto(x) and RejectedFrom(x)
+func (v Vec3Of[T]) RejectedFrom(x Vec3Of[T]) Vec3Of[T] {
+	return v.Minus(v.ProjectedOnto(x))
+}
+
+// Returns the direction v reflected at a surface with the given unit normal,
+// like reflect in GLSL
+func (v Vec3Of[T]) Reflected(normal Vec3Of[T]) Vec3Of[T] {
+	return v.Minus(normal.Times(2 * normal.Dot(&v)))
+}
+
+// Returns the unit direction v refracted at a surface with the given unit
+// normal, where eta is the ratio of the refraction indices, like refract in
+// GLSL. It is zero on total internal reflection.
+// Integer vectors take a whole eta and truncate the square root.
+func (v Vec3Of[T]) Refracted(normal Vec3Of[T], eta T) Vec3Of[T] {
+	d := normal.Dot(&v)
+	k := 1 - eta*eta*(1-d*d)
+	if k < 0 {
+		return Vec3Of[T]{}
+	}
+	return v.Times(eta).Minus(normal.Times(eta*d + T(math.Sqrt(float64(k)))))
+}
+
+// Returns the component-wise minimum of the vectors
+func (v Vec3Of[T]) Min(x Vec3Of[T]) Vec3Of[T] {
+	return Vec3Of[T]{minOf(v.X, x.X), minOf(v.Y, x.Y), minOf(v.Z, x.Z)}
+}
+
+// Returns the component-wise maximum of the vectors
+func (v Vec3Of[T]) Max(x Vec3Of[T]) Vec3Of[T] {
+	return Vec3Of[T]{maxOf(v.X, x.X), maxOf(v.Y, x.Y), maxOf(v.Z, x.Z)}
+}
+
+// Returns v with each component clamped to the range given by min and max
+func (v Vec3Of[T]) Clamp(min, max Vec3Of[T]) Vec3Of[T] {
+	return v.Max(min).Min(max)
+}
+
+// Returns the component-wise absolute value
+func (v Vec3Of[T]) Abs() Vec3Of[T] {
+	return Vec3Of[T]{absOf(v.X), absOf(v.Y), absOf(v.Z)}
+}
+
+// Returns the component-wise product of the vectors
+func (v Vec3Of[T]) Mul(x Vec3Of[T]) Vec3Of[T] {
+	return Vec3Of[T]{v.X * x.X, v.Y * x.Y, v.Z * x.Z}
+}
+
+// Returns the component-wise quotient of the vectors. Float components
+// divided by zero become infinite or NaN, integer quotients are truncated and
+// dividing an integer by zero panics.
+func (v Vec3Of[T]) Div(x Vec3Of[T]) Vec3Of[T] {
+	return Vec3Of[T]{v.X / x.X, v.Y / x.Y, v.Z / x.Z}
+}
+
+// Returns the vector scaled to length 1, or the zero vector if v is zero
+// where Normalized would give NaN
+// Integer components are truncated towards zero, so only vectors along an
+// axis keep a nonzero component.
+func (v Vec3Of[T]) SafeNormalized() Vec3Of[T] {
+	l := math.Sqrt(float64(v.X)*float64(v.X) + float64(v.Y)*float64(v.Y) + float64(v.Z)*float64(v.Z))
+	if l == 0 {
+		return Vec3Of[T]{}
+	}
+	return Vec3Of[T]{T(float64(v.X) / l), T(float64(v.Y) / l), T(float64(v.Z) / l)}
+}
+
+// Normalizes the vector unless it is zero. Returns false if it is.
+func (v *Vec3Of[T]) SafeNormalize() bool {
+	n := v.SafeNormalized()
+	if n == (Vec3Of[T]{}) {
+		return false
+	}
+	*v = n
+	return true
+}
+
+// Returns the vector extended by the given w, use 1 for points and 0 for
+// directions
+func (v Vec3Of[T]) Vec4(w T) Vec4Of[T] {
+	return Vec4Of[T]{v.X, v.Y, v.Z, w}
+}
+
+func (v *Vec3Of[T]) String() string {
+	return fmt.Sprintf("Vec3(%s, %s, %s)", formatOf(v.X), formatOf(v.Y), formatOf(v.Z))
 }
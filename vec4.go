@@ -1,33 +1,49 @@
 package mathgl
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+)
 
 // 4 dimensional vector.
-type Vec4 struct {
-	X, Y, Z, W float32
+type Vec4Of[T Number] struct {
+	X, Y, Z, W T
 }
 
-// Fills the vector with the given float32
-func (v *Vec4) Fill(x, y, z, w float32) {
+// Vectors with float32, float64 and int32 elements.
+type (
+	Vec4  = Vec4Of[float32]
+	Vec4d = Vec4Of[float64]
+	Vec4i = Vec4Of[int32]
+)
+
+// Fills the vector with the given values
+func (v *Vec4Of[T]) Fill(x, y, z, w T) {
 	v.X = x
 	v.Y = y
 	v.Z = z
 	v.W = w
 }
 
-// Returns the length  as float32
-func (v *Vec4) Length() float32 {
-	return Fsqrt32(Fsqr32(v.X) + Fsqr32(v.Y) + Fsqr32(v.Z) + Fsqr32(v.W))
+// Returns the length
+// Integer lengths are truncated.
+func (v *Vec4Of[T]) Length() T {
+	return sqrtOf(v.X*v.X + v.Y*v.Y + v.Z*v.Z + v.W*v.W)
 }
 
-// Returns the length as square as float32
-func (v *Vec4) LengthSq() float32 {
-	return Fsqr32(v.X) + Fsqr32(v.Y) + Fsqr32(v.Z) + Fsqr32(v.W)
+// Returns the length as square
+func (v *Vec4Of[T]) LengthSq() T {
+	return v.X*v.X + v.Y*v.Y + v.Z*v.Z + v.W*v.W
 }
 
-// Normalize the vector
-func (v *Vec4) Normalize() {
-	var l float32 = 1.0 / v.Length()
+// Normalize the vector. A zero vector becomes NaN, see SafeNormalize
+// Integer vectors are normalized like SafeNormalized.
+func (v *Vec4Of[T]) Normalize() {
+	if !isFloat[T]() {
+		*v = v.SafeNormalized()
+		return
+	}
+	var l T = 1.0 / v.Length()
 	v.X *= l
 	v.Y *= l
 	v.Z *= l
@@ -35,22 +51,22 @@ func (v *Vec4) Normalize() {
 }
 
 // Adds the given Vec4 with the vector
-func (v *Vec4) Add(x *Vec4) {
+func (v *Vec4Of[T]) Add(x *Vec4Of[T]) {
 	v.X += x.X
 	v.Y += x.Y
 	v.Z += x.Z
 	v.W += x.W
 }
 
-// Returns the cosine of the angle between the vectors as float32
-func (v *Vec4) Dot(x *Vec4) float32 {
+// Returns the cosine of the angle between the vectors
+func (v *Vec4Of[T]) Dot(x *Vec4Of[T]) T {
 	// Todo should this better be a Vec3 Dot product?
 	return v.X*x.X + v.Y*x.Y + v.Z*x.Z + v.W*x.W
 }
 
 // Saves the Vec4 perpendicular to the given Vec4 (Attention: This is a Vec3 Cross Product in a homogeneous 4D environment!)
-func (v *Vec4) Cross(x *Vec4) {
-	var t Vec4
+func (v *Vec4Of[T]) Cross(x *Vec4Of[T]) {
+	var t Vec4Of[T]
 	t.Assign(v)
 
 	v.X = (t.Y * x.Z) - (t.Z * x.Y)
@@ -59,16 +75,16 @@ func (v *Vec4) Cross(x *Vec4) {
 }
 
 // Subtracts the given Vec4 from the vector
-func (v *Vec4) Subtract(x *Vec4) {
+func (v *Vec4Of[T]) Subtract(x *Vec4Of[T]) {
 	v.X -= x.X
 	v.Y -= x.Y
 	v.Z -= x.Z
-	v.W -= v.W
+	v.W -= x.W
 }
 
 // Transforms the Vec4 by a given Mat4
-func (v *Vec4) Transform(m *Mat4) {
-	var t Vec4
+func (v *Vec4Of[T]) Transform(m *Mat4Of[T]) {
+	var t Vec4Of[T]
 	t.Assign(v)
 
 	v.X = t.X*m[0] + t.Y*m[4] + t.Z*m[8] + t.W*m[12]
@@ -78,7 +94,7 @@ func (v *Vec4) Transform(m *Mat4) {
 }
 
 /// Loops through an input slice transforming each Vec4 by the given Mat3
-func (v *Vec3) TransformArray(x []Vec4, m *Mat4) {
+func (v *Vec3Of[T]) TransformArray(x []Vec4Of[T], m *Mat4Of[T]) {
 	for _, item := range x {
 		// TODO: We should test this
 		item.Transform(m)
@@ -86,8 +102,8 @@ func (v *Vec3) TransformArray(x []Vec4, m *Mat4) {
 }
 
 
-// Scales a vector to the given length s in float32.
-func (v *Vec4) Scale(s float32) {
+// Scales a vector to the given length s.
+func (v *Vec4Of[T]) Scale(s T) {
 	v.X *= s
 	v.Y *= s
 	v.Z *= s
@@ -95,15 +111,15 @@ func (v *Vec4) Scale(s float32) {
 }
 
 // Returns true if the vectors are approximately equal in value
-func (v *Vec4) AreEqual(x *Vec4) bool {
-	return ((v.X < x.X+epsilon && v.X > x.X-epsilon) &&
-		(v.Y < x.Y+epsilon && v.Y > x.Y-epsilon) &&
-		(v.Z < x.Z+epsilon && v.Z > x.Z-epsilon) &&
-		(v.W < x.W+epsilon && v.W > x.W-epsilon))
+func (v *Vec4Of[T]) AreEqual(x *Vec4Of[T]) bool {
+	return (almostEqual(v.X, x.X) &&
+		almostEqual(v.Y, x.Y) &&
+		almostEqual(v.Z, x.Z) &&
+		almostEqual(v.W, x.W))
 }
 
 // Assigns the given Vec4 to the Vec4
-func (v *Vec4) Assign(x *Vec4) {
+func (v *Vec4Of[T]) Assign(x *Vec4Of[T]) {
 	if v == x {
 		return
 	}
@@ -115,13 +131,185 @@ func (v *Vec4) Assign(x *Vec4) {
 }
 
 // Sets all the elements of Vec4 to zero
-func (v *Vec4) Zero() {
+func (v *Vec4Of[T]) Zero() {
 	v.X = 0.0
 	v.Y = 0.0
 	v.Z = 0.0
 	v.W = 0.0
 }
 
-func (v *Vec4) String() string {
-	return fmt.Sprintf("Vec4(%f, %f, %f, %f)", v.X, v.Y, v.Z, v.W)
+// Returns the sum of the vectors, leaving both unchanged
+func (v Vec4Of[T]) Plus(x Vec4Of[T]) Vec4Of[T] {
+	return Vec4Of[T]{v.X + x.X, v.Y + x.Y, v.Z + x.Z, v.W + x.W}
+}
+
+// Returns the difference of the vectors, leaving both unchanged
+func (v Vec4Of[T]) Minus(x Vec4Of[T]) Vec4Of[T] {
+	return Vec4Of[T]{v.X - x.X, v.Y - x.Y, v.Z - x.Z, v.W - x.W}
+}
+
+// Returns the vector scaled by s
+func (v Vec4Of[T]) Times(s T) Vec4Of[T] {
+	return Vec4Of[T]{v.X * s, v.Y * s, v.Z * s, v.W * s}
+}
+
+// Returns the Vec3 cross product of the vectors, keeping W like Cross
+func (v Vec4Of[T]) Crossed(x Vec4Of[T]) Vec4Of[T] {
+	return Vec4Of[T]{v.Y*x.Z - v.Z*x.Y, v.Z*x.X - v.X*x.Z, v.X*x.Y - v.Y*x.X, v.W}
+}
+
+// Returns the vector scaled to length 1
+// Integer vectors are normalized like SafeNormalized.
+func (v Vec4Of[T]) Normalized() Vec4Of[T] {
+	if !isFloat[T]() {
+		return v.SafeNormalized()
+	}
+	l := T(1 / math.Sqrt(float64(v.X*v.X+v.Y*v.Y+v.Z*v.Z+v.W*v.W)))
+	return Vec4Of[T]{v.X * l, v.Y * l, v.Z * l, v.W * l}
+}
+
+// Returns the vector transformed by the given Mat4
+func (v Vec4Of[T]) Transformed(m *Mat4Of[T]) Vec4Of[T] {
+	return Vec4Of[T]{
+		v.X*m[0] + v.Y*m[4] + v.Z*m[8] + v.W*m[12],
+		v.X*m[1] + v.Y*m[5] + v.Z*m[9] + v.W*m[13],
+		v.X*m[2] + v.Y*m[6] + v.Z*m[10] + v.W*m[14],
+		v.X*m[3] + v.Y*m[7] + v.Z*m[11] + v.W*m[15],
+	}
+}
+
+// Returns the distance between the points
+// Integer distances are truncated.
+func (v Vec4Of[T]) Distance(x Vec4Of[T]) T {
+	return T(math.Sqrt(float64(v.DistanceSq(x))))
+}
+
+// Returns the squared distance between the points, which is cheaper to
+// compute than Distance
+func (v Vec4Of[T]) DistanceSq(x Vec4Of[T]) T {
+	d := v.Minus(x)
+	return d.X*d.X + d.Y*d.Y + d.Z*d.Z + d.W*d.W
+}
+
+// Returns the linear interpolation between v at t=0 and x at t=1
+// Integer vectors take a whole t, so they only reach v, x and their
+// extrapolations.
+func (v Vec4Of[T]) Lerp(x Vec4Of[T], t T) Vec4Of[T] {
+	return Vec4Of[T]{v.X + (x.X-v.X)*t, v.Y + (x.Y-v.Y)*t, v.Z + (x.Z-v.Z)*t, v.W + (x.W-v.W)*t}
+}
+
+// Returns the angle between the vectors in radians, from 0 to Pi. It is 0 if
+// either vector is zero.
+// Integer vectors get the angle truncated to whole radians.
+func (v Vec4Of[T]) Angle(x Vec4Of[T]) T {
+	l := float64(v.Length()) * float64(x.Length())
+	if l == 0 {
+		return 0
+	}
+	return T(math.Acos(math.Max(-1, math.Min(1, float64(v.Dot(&x))/l))))
+}
+
+// Returns the part of v parallel to x. It is zero if x is zero.
+// Integer vectors truncate the scale factor towards zero.
+func (v Vec4Of[T]) ProjectedOnto(x Vec4Of[T]) Vec4Of[T] {
+	l := x.Dot(&x)
+	if l == 0 {
+		return Vec4Of[T]{}
+	}
+	return x.Times(v.Dot(&x) / l)
+}
+
+// Returns the part of v perpendicular to x, so that v is the sum of
+// ProjectedOnto(x) and RejectedFrom(x)
+func (v Vec4Of[T]) RejectedFrom(x Vec4Of[T]) Vec4Of[T] {
+	return v.Minus(v.ProjectedOnto(x))
+}
+
+// Returns the direction v reflected at a surface with the given unit normal,
+// like reflect in GLSL
+func (v Vec4Of[T]) Reflected(normal Vec4Of[T]) Vec4Of[T] {
+	return v.Minus(normal.Times(2 * normal.Dot(&v)))
+}
+
+// Returns the unit direction v refracted at a surface with the given unit
+// normal, where eta is the ratio of the refraction indices, like refract in
+// GLSL. It is zero on total internal reflection.
+// Integer vectors take a whole eta and truncate the square root.
+func (v Vec4Of[T]) Refracted(normal Vec4Of[T], eta T) Vec4Of[T] {
+	d := normal.Dot(&v)
+	k := 1 - eta*eta*(1-d*d)
+	if k < 0 {
+		return Vec4Of[T]{}
+	}
+	return v.Times(eta).Minus(normal.Times(eta*d + T(math.Sqrt(float64(k)))))
+}
+
+// Returns the component-wise minimum of the vectors
+func (v Vec4Of[T]) Min(x Vec4Of[T]) Vec4Of[T] {
+	return Vec4Of[T]{minOf(v.X, x.X), minOf(v.Y, x.Y), minOf(v.Z, x.Z), minOf(v.W, x.W)}
+}
+
+// Returns the component-wise maximum of the vectors
+func (v Vec4Of[T]) Max(x Vec4Of[T]) Vec4Of[T] {
+	return Vec4Of[T]{maxOf(v.X, x.X), maxOf(v.Y, x.Y), maxOf(v.Z, x.Z), maxOf(v.W, x.W)}
+}
+
+// Returns v with each component clamped to the range given by min and max
+func (v Vec4Of[T]) Clamp(min, max Vec4Of[T]) Vec4Of[T] {
+	return v.Max(min).Min(max)
+}
+
+// Returns the component-wise absolute value
+func (v Vec4Of[T]) Abs() Vec4Of[T] {
+	return Vec4Of[T]{absOf(v.X), absOf(v.Y), absOf(v.Z), absOf(v.W)}
+}
+
+// Returns the component-wise product of the vectors
+func (v Vec4Of[T]) Mul(x Vec4Of[T]) Vec4Of[T] {
+	return Vec4Of[T]{v.X * x.X, v.Y * x.Y, v.Z * x.Z, v.W * x.W}
+}
+
+// Returns the component-wise quotient of the vectors. Float components
+// divided by zero become infinite or NaN, integer quotients are truncated and
+// dividing an integer by zero panics.
+func (v Vec4Of[T]) Div(x Vec4Of[T]) Vec4Of[T] {
+	return Vec4Of[T]{v.X / x.X, v.Y / x.Y, v.Z / x.Z, v.W / x.W}
+}
+
+// Returns the vector scaled to length 1, or the zero vector if v is zero
+// where Normalized would give NaN
+// Integer components are truncated towards zero, so only vectors along an
+// axis keep a nonzero component.
+func (v Vec4Of[T]) SafeNormalized() Vec4Of[T] {
+	l := math.Sqrt(float64(v.X)*float64(v.X) + float64(v.Y)*float64(v.Y) + float64(v.Z)*float64(v.Z) + float64(v.W)*float64(v.W))
+	if l == 0 {
+		return Vec4Of[T]{}
+	}
+	return Vec4Of[T]{T(float64(v.X) / l), T(float64(v.Y) / l), T(float64(v.Z) / l), T(float64(v.W) / l)}
+}
+
+// Normalizes the vector unless it is zero. Returns false if it is.
+func (v *Vec4Of[T]) SafeNormalize() bool {
+	n := v.SafeNormalized()
+	if n == (Vec4Of[T]{}) {
+		return false
+	}
+	*v = n
+	return true
+}
+
+// Returns the X, Y and Z components, dropping W
+func (v Vec4Of[T]) Vec3() Vec3Of[T] {
+	return Vec3Of[T]{v.X, v.Y, v.Z}
+}
+
+// Returns the X, Y and Z components divided by W, turning homogeneous
+// coordinates into a point
+// Integer vectors truncate the division and panic if W is zero.
+func (v Vec4Of[T]) PerspectiveDivide() Vec3Of[T] {
+	return Vec3Of[T]{v.X / v.W, v.Y / v.W, v.Z / v.W}
+}
+
+func (v *Vec4Of[T]) String() string {
+	return fmt.Sprintf("Vec4(%s, %s, %s, %s)", formatOf(v.X), formatOf(v.Y), formatOf(v.Z), formatOf(v.W))
 }
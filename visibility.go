@@ -0,0 +1,265 @@
+package mathgl
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+)
+
+// Returns the region visible from eye inside bounds, where the walls block
+// the line of sight, as a clockwise Poly. Walls are clipped to bounds and must
+// not cross each other, though they may touch; split crossing walls at their
+// intersection first. Returns nil if eye isn't strictly inside bounds.
+//
+// The polygon is computed with an angular sweep around eye in O(n log n) time.
+func VisibilityPolygon(eye Vec2, bounds AABB2, walls []Seg2) Poly {
+	if eye.X <= bounds.Min.X || eye.X >= bounds.Max.X || eye.Y <= bounds.Min.Y || eye.Y >= bounds.Max.Y {
+		return nil
+	}
+
+	corners := Poly{bounds.Min, {bounds.Min.X, bounds.Max.Y}, bounds.Max, {bounds.Max.X, bounds.Min.Y}}
+	v := visibility{eye: eye, segs: make([]Seg2, 0, len(walls)+4)}
+	v.tolerance = 1e-6 * float64(Fmax32(Fmax32(Fabs32(bounds.Min.X), Fabs32(bounds.Min.Y)),
+		Fmax32(Fabs32(bounds.Max.X), Fabs32(bounds.Max.Y))))
+	for i := range corners {
+		v.add(Seg2{corners[i], corners[(i+1)%4]})
+	}
+	for _, wall := range walls {
+		if bounds.clipSeg(&wall) {
+			v.add(wall)
+		}
+	}
+	return v.sweep()
+}
+
+// Clips the segment to the box, returns false if nothing is left. Clipped
+// endpoints lie exactly on the bound they were clipped against.
+func (b *AABB2) clipSeg(s *Seg2) bool {
+	t0, t1 := float32(0), float32(1)
+	// The bound each end was clipped against, 0 if it wasn't
+	var bound0, bound1 int
+	d := s.Ray()
+	clip := func(p, q float32, bound int) bool {
+		// Keeps the part where p*t <= q
+		if p == 0 {
+			return q >= 0
+		}
+		t := q / p
+		if p < 0 && t > t0 {
+			t0, bound0 = t, bound
+		} else if p > 0 && t < t1 {
+			t1, bound1 = t, bound
+		}
+		return t0 <= t1
+	}
+	if !clip(-d.X, s.A.X-b.Min.X, 1) || !clip(d.X, b.Max.X-s.A.X, 2) ||
+		!clip(-d.Y, s.A.Y-b.Min.Y, 3) || !clip(d.Y, b.Max.Y-s.A.Y, 4) {
+		return false
+	}
+	a, c := s.A, s.B
+	if bound0 != 0 {
+		a = b.snap(s.At(t0), bound0)
+	}
+	if bound1 != 0 {
+		c = b.snap(s.At(t1), bound1)
+	}
+	s.A, s.B = a, c
+	return true
+}
+
+// Moves v onto the given bound, 1 to 4 for min x, max x, min y and max y,
+// and into the box against rounding errors.
+func (b *AABB2) snap(v Vec2, bound int) Vec2 {
+	switch bound {
+	case 1:
+		v.X = b.Min.X
+	case 2:
+		v.X = b.Max.X
+	case 3:
+		v.Y = b.Min.Y
+	case 4:
+		v.Y = b.Max.Y
+	}
+	return Vec2{Fmin32(Fmax32(v.X, b.Min.X), b.Max.X), Fmin32(Fmax32(v.Y, b.Min.Y), b.Max.Y)}
+}
+
+type visibilityEvent struct {
+	angle float64
+	seg   int
+	start bool
+}
+
+type visibility struct {
+	eye  Vec2
+	segs []Seg2
+	// Points closer than this to a line count as on it
+	tolerance float64
+	events    []visibilityEvent
+	// Segments crossed by the ray the sweep begins with
+	initial []int
+
+	// Heap of the segments crossed by the sweep ray, the nearest one on top
+	active   []int
+	position []int
+}
+
+// Adds a wall seen counter-clockwise from the eye. Walls the line of sight
+// only grazes are ignored.
+func (v *visibility) add(s Seg2) {
+	a, b := s.A, s.B
+	a.Subtract(&v.eye)
+	b.Subtract(&v.eye)
+	c := cross2(&a, &b)
+	if c == 0 {
+		return
+	}
+	if c < 0 {
+		s.A, s.B = s.B, s.A
+		a, b = b, a
+	}
+	i := len(v.segs)
+	v.segs = append(v.segs, s)
+	start := math.Atan2(float64(a.Y), float64(a.X))
+	end := math.Atan2(float64(b.Y), float64(b.X))
+	v.events = append(v.events, visibilityEvent{start, i, true}, visibilityEvent{end, i, false})
+	if start > end {
+		v.initial = append(v.initial, i)
+	}
+}
+
+func (v *visibility) sweep() Poly {
+	sort.Sort(visibilityEvents(v.events))
+	v.position = make([]int, len(v.segs))
+	for i := range v.position {
+		v.position[i] = -1
+	}
+	for _, i := range v.initial {
+		heap.Push(v, i)
+	}
+
+	var result Poly
+	emit := func(seg int, ray *Seg2) {
+		// Use the event point as it is if it lies on the segment, the
+		// intersection may be off by a rounding error
+		p := ray.B
+		if v.sign(&v.segs[seg], &p) != 0 {
+			p = ray.Isect(&v.segs[seg])
+		}
+		if len(result) == 0 || result[len(result)-1] != p {
+			result = append(result, p)
+		}
+	}
+	for i := 0; i < len(v.events); {
+		// The bounds always block the view, so the heap only runs empty if
+		// the segments can't be ordered
+		front := -1
+		if len(v.active) > 0 {
+			front = v.active[0]
+		}
+		ray := Seg2{v.eye, v.endpoint(&v.events[i])}
+
+		// Handle all events at the same angle at once, removals first
+		j := i
+		for ; j < len(v.events) && v.events[j].angle == v.events[i].angle; j++ {
+			if e := &v.events[j]; !e.start && v.position[e.seg] != -1 {
+				heap.Remove(v, v.position[e.seg])
+			}
+		}
+		for k := i; k < j; k++ {
+			if e := &v.events[k]; e.start && v.position[e.seg] == -1 {
+				heap.Push(v, e.seg)
+			}
+		}
+		i = j
+
+		if len(v.active) > 0 && v.active[0] != front {
+			if front != -1 {
+				emit(front, &ray)
+			}
+			emit(v.active[0], &ray)
+		}
+	}
+	if len(result) > 1 && result[0] == result[len(result)-1] {
+		result = result[:len(result)-1]
+	}
+
+	result.Reverse()
+	return result
+}
+
+func (v *visibility) endpoint(e *visibilityEvent) Vec2 {
+	if e.start {
+		return v.segs[e.seg].A
+	}
+	return v.segs[e.seg].B
+}
+
+// Returns true if segment a blocks the view onto segment b. Both must be
+// crossed by the sweep ray, so as they don't cross one of them lies entirely
+// on one side of the line through the other. Points on the line, like shared
+// endpoints or one segment touching the other, count for either side.
+func (v *visibility) inFront(a, b *Seg2) bool {
+	if side := v.side(a, &b.A, &b.B); side != 0 {
+		return side < 0
+	}
+	if side := v.side(b, &a.A, &a.B); side != 0 {
+		return side > 0
+	}
+	return false
+}
+
+// Returns 1 if both points are on the same side of the line through s as the
+// eye, -1 if both are on the other side and 0 otherwise. Points on the line
+// count for either side.
+func (v *visibility) side(s *Seg2, p, q *Vec2) int {
+	eye, sp, sq := v.sign(s, &v.eye), v.sign(s, p), v.sign(s, q)
+	if sp == 0 && sq == 0 {
+		return 0
+	}
+	if sp*eye >= 0 && sq*eye >= 0 {
+		return 1
+	}
+	if sp*eye <= 0 && sq*eye <= 0 {
+		return -1
+	}
+	return 0
+}
+
+// Returns on which side of the line through s the point is, 1 for left, -1
+// for right and 0 if it is within the tolerance of the line.
+func (v *visibility) sign(s *Seg2, u *Vec2) int {
+	dx, dy := float64(s.B.X-s.A.X), float64(s.B.Y-s.A.Y)
+	c := dx*float64(u.Y-s.A.Y) - dy*float64(u.X-s.A.X)
+	if tolerance := v.tolerance * math.Sqrt(dx*dx+dy*dy); c > tolerance {
+		return 1
+	} else if c < -tolerance {
+		return -1
+	}
+	return 0
+}
+
+func (v *visibility) Len() int { return len(v.active) }
+func (v *visibility) Less(i, j int) bool {
+	return v.inFront(&v.segs[v.active[i]], &v.segs[v.active[j]])
+}
+func (v *visibility) Swap(i, j int) {
+	v.active[i], v.active[j] = v.active[j], v.active[i]
+	v.position[v.active[i]] = i
+	v.position[v.active[j]] = j
+}
+func (v *visibility) Push(x interface{}) {
+	v.position[x.(int)] = len(v.active)
+	v.active = append(v.active, x.(int))
+}
+func (v *visibility) Pop() interface{} {
+	x := v.active[len(v.active)-1]
+	v.active = v.active[:len(v.active)-1]
+	v.position[x] = -1
+	return x
+}
+
+type visibilityEvents []visibilityEvent
+
+func (e visibilityEvents) Len() int           { return len(e) }
+func (e visibilityEvents) Swap(i, j int)      { e[i], e[j] = e[j], e[i] }
+func (e visibilityEvents) Less(i, j int) bool { return e[i].angle < e[j].angle }